@@ -0,0 +1,599 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entname
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+
+	"github.com/openconfig/entity-naming/namer"
+)
+
+// Device is a network device whose vendor and hardware model have been
+// validated. Its methods mirror the package-level naming functions without
+// repeating the vendor lookup and validation on every call.
+type Device struct {
+	params DeviceParams
+	namer  namer.Namer
+}
+
+// NewDevice returns a Device with the given parameters, or an error if the
+// vendor is not supported or its Namer rejects the hardware model. The
+// built-in Namers accept every hardware model: they name the entities of
+// models missing from their tables as those of a default family, and report
+// ErrUnsupportedHardwareModel only from calls that need model-specific
+// limits, such as Capabilities.
+func NewDevice(dp *DeviceParams) (*Device, error) {
+	n, err := lookupNamer(dp)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := n.(namer.HardwareModelValidator); ok {
+		if err := v.ValidateHardwareModel(); err != nil {
+			return nil, err
+		}
+	}
+	return &Device{params: *dp, namer: n}, nil
+}
+
+// Params returns the parameters of the device.
+func (d *Device) Params() *DeviceParams {
+	dp := d.params
+	return &dp
+}
+
+func (d *Device) String() string {
+	if d == nil {
+		return nilString
+	}
+	return d.params.String()
+}
+
+// extension returns the Namer of the device as the extension interface E, or
+// an error wrapping ErrUnsupportedEntity if the Namer does not implement it.
+// The description completes the sentence "the Namer cannot ...".
+func extension[E any](d *Device, desc string) (E, error) {
+	e, ok := d.namer.(E)
+	if !ok {
+		return e, fmt.Errorf("the Namer for %v cannot %s: %w", d, desc, ErrUnsupportedEntity)
+	}
+	return e, nil
+}
+
+// LoopbackInterface returns the vendor-specific name of the loopback
+// interface with the given zero-based index.
+func (d *Device) LoopbackInterface(index int) (string, error) {
+	if index < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindLoopback, Index: index, Max: -1}
+	}
+	return d.namer.LoopbackInterface(uint(index))
+}
+
+// AggregateInterface returns the vendor-specific name of the aggregate
+// interface with the given zero-based index.
+func (d *Device) AggregateInterface(index int) (string, error) {
+	if index < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindAggregate, Index: index, Max: -1}
+	}
+	return d.namer.AggregateInterface(uint(index))
+}
+
+// AggregateMemberInterface returns the vendor-specific name of the member
+// interface bound to the aggregate interface with the given zero-based index.
+func (d *Device) AggregateMemberInterface(index int) (string, error) {
+	if index < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindAggregateMember, Index: index, Max: -1}
+	}
+	return d.namer.AggregateMemberInterface(uint(index))
+}
+
+// Port returns the vendor-specific name of the physical interface with the
+// given port parameters.
+func (d *Device) Port(pp *PortParams) (string, error) {
+	npp, err := namerPortParams(pp, d.namer.IsFixedFormFactor())
+	if err != nil {
+		return "", err
+	}
+	return d.namer.Port(npp)
+}
+
+// BreakoutChannels returns the vendor-specific names of the channels of the
+// physical interface with the given port parameters when it is broken out
+// with the given breakout mode. See the BreakoutChannels function for details.
+func (d *Device) BreakoutChannels(pp *PortParams, mode BreakoutMode) ([]string, error) {
+	if mode.NumChannels <= 0 {
+		return nil, fmt.Errorf("number of channels must be positive, got %d: %w", mode.NumChannels, ErrInvalidBreakout)
+	}
+	if pp.ChannelState == Unchannelizable {
+		return nil, fmt.Errorf("cannot break out an unchannelizable port: %w", ErrInvalidBreakout)
+	}
+	names := make([]string, 0, mode.NumChannels)
+	for i := 0; i < mode.NumChannels; i++ {
+		channel := *pp
+		channel.ChannelIndex = i
+		channel.ChannelState = Channelized
+		channel.Breakout = mode
+		name, err := d.Port(&channel)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// ParsePort returns the port parameters of the physical interface with the
+// given vendor-specific name. See the ParsePort function for details.
+func (d *Device) ParsePort(name string) (*PortParams, error) {
+	pp, err := extension[namer.PortParser](d, "parse port names")
+	if err != nil {
+		return nil, err
+	}
+	npp, err := pp.ParsePort(name)
+	if err != nil {
+		return nil, err
+	}
+	return portParams(npp), nil
+}
+
+// Transceiver returns the vendor-specific name of the transceiver component
+// of the physical interface with the given port parameters. See the
+// Transceiver function for details.
+func (d *Device) Transceiver(pp *PortParams) (string, error) {
+	n, err := extension[namer.TransceiverNamer](d, "name transceivers")
+	if err != nil {
+		return "", err
+	}
+	npp, err := namerPortParams(pp, d.namer.IsFixedFormFactor())
+	if err != nil {
+		return "", err
+	}
+	return n.Transceiver(npp)
+}
+
+// OpticalChannel returns the vendor-specific name of the optical channel
+// component with the given zero-based index of the physical interface with the
+// given port parameters. See the OpticalChannel function for details.
+func (d *Device) OpticalChannel(pp *PortParams, index int) (string, error) {
+	n, err := extension[namer.OpticalChannelNamer](d, "name optical channels")
+	if err != nil {
+		return "", err
+	}
+	npp, err := namerPortParams(pp, d.namer.IsFixedFormFactor())
+	if err != nil {
+		return "", err
+	}
+	if index < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindOpticalChannel, Index: index, Max: -1}
+	}
+	return n.OpticalChannel(npp, uint(index))
+}
+
+// LogicalChannelIndex returns the vendor-specific index of the terminal device
+// logical channel of the given kind that carries the physical interface with
+// the given port parameters. See the LogicalChannelIndex function for details.
+func (d *Device) LogicalChannelIndex(pp *PortParams, kind LogicalChannelKind) (uint32, error) {
+	n, err := extension[namer.LogicalChannelIndexer](d, "index logical channels")
+	if err != nil {
+		return 0, err
+	}
+	npp, err := namerPortParams(pp, d.namer.IsFixedFormFactor())
+	if err != nil {
+		return 0, err
+	}
+	return n.LogicalChannelIndex(npp, kind)
+}
+
+// PortASIC returns the vendor-specific name of the integrated circuit
+// component that serves the physical interface with the given port
+// parameters. See the PortASIC function for details.
+func (d *Device) PortASIC(pp *PortParams) (string, error) {
+	n, err := extension[namer.PortASICNamer](d, "map ports to integrated circuits")
+	if err != nil {
+		return "", err
+	}
+	npp, err := namerPortParams(pp, d.namer.IsFixedFormFactor())
+	if err != nil {
+		return "", err
+	}
+	return n.PortASIC(npp)
+}
+
+// Subinterface returns the vendor-specific name of the subinterface with the
+// given index of the interface with the given vendor-specific name.
+func (d *Device) Subinterface(parent string, subIndex int) (string, error) {
+	if parent == "" {
+		return "", fmt.Errorf("parent interface name cannot be empty: %w", ErrInvalidParentInterface)
+	}
+	if strings.Contains(parent, ".") {
+		return "", fmt.Errorf("parent interface %q cannot be a subinterface: %w", parent, ErrInvalidParentInterface)
+	}
+	if subIndex < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindSubinterface, Index: subIndex, Max: -1}
+	}
+	n, err := extension[namer.SubinterfaceNamer](d, "name subinterfaces")
+	if err != nil {
+		return "", err
+	}
+	return n.Subinterface(parent, uint(subIndex))
+}
+
+// PortSubinterface returns the vendor-specific name of the subinterface with
+// the given index of the physical interface with the given port parameters.
+func (d *Device) PortSubinterface(pp *PortParams, subIndex int) (string, error) {
+	parent, err := d.Port(pp)
+	if err != nil {
+		return "", err
+	}
+	return d.Subinterface(parent, subIndex)
+}
+
+// AggregateSubinterface returns the vendor-specific name of the subinterface
+// with the given index of the aggregate interface with the given zero-based
+// index.
+func (d *Device) AggregateSubinterface(index, subIndex int) (string, error) {
+	parent, err := d.AggregateInterface(index)
+	if err != nil {
+		return "", err
+	}
+	return d.Subinterface(parent, subIndex)
+}
+
+// LoopbackSubinterface returns the vendor-specific name of the subinterface
+// with the given index of the loopback interface with the given zero-based
+// index.
+func (d *Device) LoopbackSubinterface(index, subIndex int) (string, error) {
+	parent, err := d.LoopbackInterface(index)
+	if err != nil {
+		return "", err
+	}
+	return d.Subinterface(parent, subIndex)
+}
+
+// VLANInterface returns the vendor-specific name of the routed VLAN interface
+// with the given VLAN ID.
+func (d *Device) VLANInterface(vlanID int) (string, error) {
+	if vlanID < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindVLANInterface, Index: vlanID, Max: -1}
+	}
+	n, err := extension[namer.VLANInterfaceNamer](d, "name VLAN interfaces")
+	if err != nil {
+		return "", err
+	}
+	return n.VLANInterface(uint(vlanID))
+}
+
+// TunnelInterface returns the vendor-specific name of the tunnel interface of
+// the given kind with the given zero-based index. See the TunnelInterface
+// function for details.
+func (d *Device) TunnelInterface(kind TunnelKind, index int) (string, error) {
+	return d.LinecardTunnelInterface(kind, 0, index)
+}
+
+// LinecardTunnelInterface returns the vendor-specific name of the tunnel
+// interface of the given kind with the given zero-based index, hosted by the
+// linecard with the given zero-based index.
+func (d *Device) LinecardTunnelInterface(kind TunnelKind, linecardIndex, index int) (string, error) {
+	switch kind {
+	case TunnelGRE, TunnelIPinIP, TunnelMPLSTE:
+	default:
+		return "", fmt.Errorf("unknown tunnel kind %q: %w", kind, ErrUnsupportedEntity)
+	}
+	if linecardIndex < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindLinecard, Index: linecardIndex, Max: -1}
+	}
+	if index < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindTunnelInterface, Index: index, Max: -1}
+	}
+	n, err := extension[namer.TunnelInterfaceNamer](d, "name tunnel interfaces")
+	if err != nil {
+		return "", err
+	}
+	return n.TunnelInterface(&namer.TunnelParams{
+		Kind:          kind,
+		Index:         uint(index),
+		LinecardIndex: uint(linecardIndex),
+	})
+}
+
+// Classify returns the kind of the entity with the given vendor-specific name.
+// See the Classify function for details.
+func (d *Device) Classify(name string) (*Entity, error) {
+	pp, canParsePorts := d.namer.(namer.PortParser)
+	ip, canParseIndices := d.namer.(namer.IndexParser)
+	for _, kind := range classifyOrder {
+		if kind == KindPort {
+			if !canParsePorts {
+				continue
+			}
+			if npp, err := pp.ParsePort(name); err == nil {
+				return &Entity{Kind: kind, Port: portParams(npp)}, nil
+			}
+			continue
+		}
+		if !canParseIndices {
+			continue
+		}
+		if index, err := ip.ParseIndex(kind, name); err == nil {
+			return &Entity{Kind: kind, Index: int(index)}, nil
+		}
+	}
+	return nil, fmt.Errorf("%q is not the name of any known entity for %v: %w", name, d, ErrUnsupportedEntity)
+}
+
+// Linecard returns the vendor-specific name of the linecard with the given
+// zero-based index.
+func (d *Device) Linecard(index int) (string, error) {
+	if index < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindLinecard, Index: index, Max: -1}
+	}
+	return d.namer.Linecard(uint(index))
+}
+
+// ControllerCard returns the vendor-specific name of the controller card with
+// the given zero-based index.
+func (d *Device) ControllerCard(index int) (string, error) {
+	if index < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindControllerCard, Index: index, Max: -1}
+	}
+	return d.namer.ControllerCard(uint(index))
+}
+
+// Fabric returns the vendor-specific name of the fabric with the given
+// zero-based index.
+func (d *Device) Fabric(index int) (string, error) {
+	if index < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindFabric, Index: index, Max: -1}
+	}
+	return d.namer.Fabric(uint(index))
+}
+
+// PowerSupply returns the vendor-specific name of the power supply with the
+// given zero-based index.
+func (d *Device) PowerSupply(index int) (string, error) {
+	if index < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindPowerSupply, Index: index, Max: -1}
+	}
+	n, err := extension[namer.EnvironmentNamer](d, "name power supplies")
+	if err != nil {
+		return "", err
+	}
+	return n.PowerSupply(uint(index))
+}
+
+// FanTray returns the vendor-specific name of the fan tray with the given
+// zero-based index.
+func (d *Device) FanTray(index int) (string, error) {
+	if index < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindFanTray, Index: index, Max: -1}
+	}
+	n, err := extension[namer.EnvironmentNamer](d, "name fan trays")
+	if err != nil {
+		return "", err
+	}
+	return n.FanTray(uint(index))
+}
+
+// Fan returns the vendor-specific name of the fan with the given zero-based
+// index in the fan tray with the given zero-based index.
+func (d *Device) Fan(trayIndex, fanIndex int) (string, error) {
+	if trayIndex < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindFanTray, Index: trayIndex, Max: -1}
+	}
+	if fanIndex < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindFan, Index: fanIndex, Max: -1}
+	}
+	n, err := extension[namer.EnvironmentNamer](d, "name fans")
+	if err != nil {
+		return "", err
+	}
+	return n.Fan(uint(trayIndex), uint(fanIndex))
+}
+
+// IntegratedCircuit returns the vendor-specific name of the integrated
+// circuit with the given zero-based index on the linecard with the given
+// zero-based index. Linecard index zero is the chassis of a fixed form factor
+// device.
+func (d *Device) IntegratedCircuit(linecardIndex, chipIndex int) (string, error) {
+	if linecardIndex < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindLinecard, Index: linecardIndex, Max: -1}
+	}
+	if chipIndex < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindIntegratedCircuit, Index: chipIndex, Max: -1}
+	}
+	n, err := extension[namer.ChipNamer](d, "name integrated circuits")
+	if err != nil {
+		return "", err
+	}
+	return n.IntegratedCircuit(uint(linecardIndex), uint(chipIndex))
+}
+
+// CPU returns the vendor-specific name of the CPU with the given zero-based
+// index on the card of the given kind with the given zero-based index. The
+// kind of the card must be KindLinecard or KindControllerCard.
+func (d *Device) CPU(cardKind EntityKind, cardIndex, cpuIndex int) (string, error) {
+	if cardIndex < 0 {
+		return "", &IndexOutOfRangeError{Entity: cardKind, Index: cardIndex, Max: -1}
+	}
+	if cpuIndex < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindCPU, Index: cpuIndex, Max: -1}
+	}
+	n, err := extension[namer.ChipNamer](d, "name CPUs")
+	if err != nil {
+		return "", err
+	}
+	return n.CPU(cardKind, uint(cardIndex), uint(cpuIndex))
+}
+
+// Chassis returns the vendor-specific name of the chassis with the given
+// zero-based index.
+func (d *Device) Chassis(index int) (string, error) {
+	if index < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindChassis, Index: index, Max: -1}
+	}
+	n, err := extension[namer.ChassisNamer](d, "name chassis")
+	if err != nil {
+		return "", err
+	}
+	return n.Chassis(uint(index))
+}
+
+// Backplane returns the vendor-specific name of the backplane of the chassis
+// with the given zero-based index.
+func (d *Device) Backplane(chassisIndex int) (string, error) {
+	if chassisIndex < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindChassis, Index: chassisIndex, Max: -1}
+	}
+	n, err := extension[namer.ChassisNamer](d, "name backplanes")
+	if err != nil {
+		return "", err
+	}
+	return n.Backplane(uint(chassisIndex))
+}
+
+// ManagementInterface returns the vendor-specific name of the management
+// interface with the given zero-based index on the controller card with the
+// given zero-based index.
+func (d *Device) ManagementInterface(controllerCardIndex, index int) (string, error) {
+	if controllerCardIndex < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindControllerCard, Index: controllerCardIndex, Max: -1}
+	}
+	if index < 0 {
+		return "", &IndexOutOfRangeError{Entity: KindManagementInterface, Index: index, Max: -1}
+	}
+	n, err := extension[namer.ManagementInterfaceNamer](d, "name management interfaces")
+	if err != nil {
+		return "", err
+	}
+	return n.ManagementInterface(uint(controllerCardIndex), uint(index))
+}
+
+// maxConsecutiveRejects bounds the number of consecutive indices that the
+// All iterators try after the last valid name before they give up.
+const maxConsecutiveRejects = 64
+
+// AllLinecards returns an iterator over the indices and names of every valid
+// linecard of the device, in increasing index order.
+func (d *Device) AllLinecards() iter.Seq2[int, string] {
+	return d.all(func(c *namer.Capabilities) uint { return c.MaxLinecards }, d.namer.Linecard)
+}
+
+// AllControllerCards returns an iterator over the indices and names of every
+// valid controller card of the device, in increasing index order.
+func (d *Device) AllControllerCards() iter.Seq2[int, string] {
+	return d.all(func(c *namer.Capabilities) uint { return c.MaxControllerCards }, d.namer.ControllerCard)
+}
+
+// AllFabrics returns an iterator over the indices and names of every valid
+// fabric of the device, in increasing index order.
+func (d *Device) AllFabrics() iter.Seq2[int, string] {
+	return d.all(func(c *namer.Capabilities) uint { return c.MaxFabrics }, d.namer.Fabric)
+}
+
+// AllPowerSupplies returns an iterator over the indices and names of every
+// valid power supply of the device, in increasing index order.
+func (d *Device) AllPowerSupplies() iter.Seq2[int, string] {
+	n, ok := d.namer.(namer.EnvironmentNamer)
+	if !ok {
+		return emptySeq
+	}
+	return d.all(func(c *namer.Capabilities) uint { return c.MaxPowerSupplies }, n.PowerSupply)
+}
+
+// AllFanTrays returns an iterator over the indices and names of every valid
+// fan tray of the device, in increasing index order.
+func (d *Device) AllFanTrays() iter.Seq2[int, string] {
+	n, ok := d.namer.(namer.EnvironmentNamer)
+	if !ok {
+		return emptySeq
+	}
+	return d.all(func(c *namer.Capabilities) uint { return c.MaxFanTrays }, n.FanTray)
+}
+
+// all returns an iterator that walks the index space from zero, skipping the
+// indices the vendor rejects, until it has yielded the maximum number of names
+// permitted by the capabilities of the device. If the Namer does not report
+// capabilities, the walk ends only after too many consecutive rejects.
+func (d *Device) all(maxFn func(*namer.Capabilities) uint, nameFn func(uint) (string, error)) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		limit := ^uint(0)
+		if cr, ok := d.namer.(namer.CapabilitiesReporter); ok {
+			caps, err := cr.Capabilities()
+			if err != nil {
+				return
+			}
+			limit = maxFn(caps)
+		}
+		for index, found, rejects := uint(0), uint(0), 0; found < limit && rejects < maxConsecutiveRejects; index++ {
+			name, err := nameFn(index)
+			if err != nil {
+				rejects++
+				continue
+			}
+			rejects = 0
+			found++
+			if !yield(int(index), name) {
+				return
+			}
+		}
+	}
+}
+
+// Capabilities returns the naming limits of the device.
+func (d *Device) Capabilities() (*DeviceCapabilities, error) {
+	cr, err := extension[namer.CapabilitiesReporter](d, "report capabilities")
+	if err != nil {
+		return nil, err
+	}
+	caps, err := cr.Capabilities()
+	if err != nil {
+		return nil, err
+	}
+	return &DeviceCapabilities{
+		MaxLoopbacks:       int(caps.MaxLoopbacks),
+		MaxAggregates:      int(caps.MaxAggregates),
+		MaxLinecards:       int(caps.MaxLinecards),
+		MaxControllerCards: int(caps.MaxControllerCards),
+		MaxFabrics:         int(caps.MaxFabrics),
+		MaxPowerSupplies:   int(caps.MaxPowerSupplies),
+		MaxFanTrays:        int(caps.MaxFanTrays),
+		PortSpeeds:         slices.Clone(caps.PortSpeeds),
+		FixedFormFactor:    d.namer.IsFixedFormFactor(),
+	}, nil
+}
+
+// CommonQoSQueues returns the vendors-specific queues names for the common
+// QoS classes.
+func (d *Device) CommonQoSQueues(qos *QoSParams) (*CommonQoSQueueNames, error) {
+	nqp, err := namerQoSParams(qos)
+	if err != nil {
+		return nil, err
+	}
+	cqq, err := d.namer.CommonQoSQueues(nqp)
+	if err != nil {
+		return nil, err
+	}
+	return &CommonQoSQueueNames{map[QoSClass]string{
+		QoSNC1: cqq.NC1,
+		QoSAF4: cqq.AF4,
+		QoSAF3: cqq.AF3,
+		QoSAF2: cqq.AF2,
+		QoSAF1: cqq.AF1,
+		QoSBE1: cqq.BE1,
+		QoSBE0: cqq.BE0,
+	}}, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entname
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestNewDevice(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		const want = "fakeFabric0"
+		setFakeNamer(&fakeNamer{FabricFn: func(uint) (string, error) {
+			return want, nil
+		}})
+		d, err := NewDevice(devParams)
+		if err != nil {
+			t.Fatalf("NewDevice(%v) got error %v", devParams, err)
+		}
+		if got := d.Params(); *got != *devParams {
+			t.Errorf("NewDevice(%v).Params() got %v, want %v", devParams, got, devParams)
+		}
+		got, err := d.Fabric(0)
+		if err != nil {
+			t.Errorf("Fabric(0) got error %v", err)
+		}
+		if got != want {
+			t.Errorf("Fabric(0) got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("unknown vendor", func(t *testing.T) {
+		dp := &DeviceParams{Vendor: Vendor("unknown")}
+		_, err := NewDevice(dp)
+		if !errors.Is(err, ErrUnsupportedVendor) {
+			t.Errorf("NewDevice(%v) got error %v, want %v", dp, err, ErrUnsupportedVendor)
+		}
+	})
+
+	t.Run("unsupported hardware model", func(t *testing.T) {
+		const wantErr = "fakeHardwareModelErr"
+		setFakeNamer(&fakeNamer{ValidateHardwareModelFn: func() error {
+			return errors.New(wantErr)
+		}})
+		_, err := NewDevice(devParams)
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("NewDevice(%v) got error %v, want substring %q", devParams, err, wantErr)
+		}
+	})
+
+	t.Run("unlisted or unnormalized hardware model", func(t *testing.T) {
+		for _, dp := range []*DeviceParams{
+			{Vendor: VendorCiena, HardwareModel: "WR99"},
+			{Vendor: VendorCiena, HardwareModel: "wr13"},
+			{Vendor: VendorArista, HardwareModel: "DCS-7150S-24"},
+			{Vendor: VendorArista, HardwareModel: "7500R"},
+			{Vendor: VendorCisco, HardwareModel: "8202-32FH-M"},
+			{Vendor: VendorCisco, HardwareModel: "8812"},
+			{Vendor: VendorJuniper, HardwareModel: "PTX10004"},
+			{Vendor: VendorJuniper, HardwareModel: "MX480"},
+			{Vendor: VendorNokia, HardwareModel: "7220 IXR-H4"},
+			{Vendor: VendorNokia, HardwareModel: "7250 IXR-e"},
+		} {
+			d, err := NewDevice(dp)
+			if err != nil {
+				t.Errorf("NewDevice(%v) got error: %v", dp, err)
+				continue
+			}
+			if _, err := d.LoopbackInterface(0); err != nil {
+				t.Errorf("LoopbackInterface(0) of %v got error: %v", dp, err)
+			}
+			if _, err := d.AggregateInterface(0); err != nil {
+				t.Errorf("AggregateInterface(0) of %v got error: %v", dp, err)
+			}
+			if _, err := LoopbackInterface(dp, 0); err != nil {
+				t.Errorf("LoopbackInterface(%v, 0) got error: %v", dp, err)
+			}
+		}
+	})
+}
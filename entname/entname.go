@@ -16,15 +16,18 @@
 package entname
 
 import (
+	"errors"
 	"fmt"
+	"iter"
 	"strings"
+	"sync"
 
 	"github.com/openconfig/entity-naming/internal/arista"
 	"github.com/openconfig/entity-naming/internal/ciena"
 	"github.com/openconfig/entity-naming/internal/cisco"
 	"github.com/openconfig/entity-naming/internal/juniper"
-	"github.com/openconfig/entity-naming/internal/namer"
 	"github.com/openconfig/entity-naming/internal/nokia"
+	"github.com/openconfig/entity-naming/namer"
 	"github.com/openconfig/entity-naming/oc"
 )
 
@@ -40,12 +43,36 @@ const (
 	VendorCiena   = Vendor("Ciena")
 )
 
-var namerFactories = map[Vendor]func(string) namer.Namer{
-	VendorArista:  func(hwm string) namer.Namer { return &arista.Namer{HardwareModel: hwm} },
-	VendorCisco:   func(hwm string) namer.Namer { return &cisco.Namer{HardwareModel: hwm} },
-	VendorJuniper: func(hwm string) namer.Namer { return &juniper.Namer{HardwareModel: hwm} },
-	VendorNokia:   func(hwm string) namer.Namer { return &nokia.Namer{HardwareModel: hwm} },
-	VendorCiena:   func(hwm string) namer.Namer { return &ciena.Namer{HardwareModel: hwm} },
+var (
+	namerFactoriesMu sync.RWMutex
+	namerFactories   = map[Vendor]func(string) namer.Namer{
+		VendorArista:  func(hwm string) namer.Namer { return &arista.Namer{HardwareModel: hwm} },
+		VendorCisco:   func(hwm string) namer.Namer { return &cisco.Namer{HardwareModel: hwm} },
+		VendorJuniper: func(hwm string) namer.Namer { return &juniper.Namer{HardwareModel: hwm} },
+		VendorNokia:   func(hwm string) namer.Namer { return &nokia.Namer{HardwareModel: hwm} },
+		VendorCiena:   func(hwm string) namer.Namer { return &ciena.Namer{HardwareModel: hwm} },
+	}
+)
+
+// RegisterVendor registers a factory of Namers for the given vendor, which
+// allows vendors that are not built into this library to be named. The
+// factory is called with the hardware model of the device. It returns an
+// error if a Namer is already registered for the vendor. It is safe to call
+// RegisterVendor concurrently with itself and the naming functions.
+func RegisterVendor(v Vendor, factory func(hwModel string) namer.Namer) error {
+	if v == "" {
+		return errors.New("vendor cannot be empty")
+	}
+	if factory == nil {
+		return fmt.Errorf("factory for vendor %v cannot be nil", v)
+	}
+	namerFactoriesMu.Lock()
+	defer namerFactoriesMu.Unlock()
+	if _, ok := namerFactories[v]; ok {
+		return fmt.Errorf("a Namer is already registered for vendor %v", v)
+	}
+	namerFactories[v] = factory
+	return nil
 }
 
 const nilString = "nil"
@@ -82,6 +109,9 @@ type PortParams struct {
 	SlotIndex, PICIndex, PortIndex, ChannelIndex int
 	ChannelState                                 PortChannelState
 	Speed                                        oc.E_IfEthernet_ETHERNET_SPEED
+	// Breakout is the breakout mode of a channelized port. The zero value
+	// means the breakout mode is not known.
+	Breakout BreakoutMode
 }
 
 func (pp *PortParams) String() string {
@@ -91,75 +121,183 @@ func (pp *PortParams) String() string {
 	return fmt.Sprintf("%+v", *pp)
 }
 
+// BreakoutMode is the breakout mode of a channelized port. The zero value
+// means the breakout mode is not known; any other value must have a
+// ChannelSpeed.
+type BreakoutMode struct {
+	// NumChannels is the number of channels of the port, or zero if it is not
+	// known. If it is known, the channel index must be less than it.
+	NumChannels int
+	// ChannelSpeed is the ethernet link speed of each channel of the port. It
+	// is required whenever the breakout mode is set, even if NumChannels is
+	// not known, and naming fails with ErrUnsupportedSpeed if it is unset.
+	ChannelSpeed oc.E_IfEthernet_ETHERNET_SPEED
+}
+
+func (bm *BreakoutMode) String() string {
+	if bm == nil {
+		return nilString
+	}
+	return fmt.Sprintf("%+v", *bm)
+}
+
 // LoopbackInterface returns the vendor-specific name of the loopback
 // interface with the given zero-based index.
 func LoopbackInterface(dp *DeviceParams, index int) (string, error) {
-	n, err := lookupNamer(dp)
+	d, err := NewDevice(dp)
 	if err != nil {
 		return "", err
 	}
-	if index < 0 {
-		return "", fmt.Errorf("interface index cannot be negative: %d", index)
-	}
-	return n.LoopbackInterface(uint(index))
+	return d.LoopbackInterface(index)
 }
 
 // AggregateInterface returns the vendor-specific name of the aggregate
 // interface with the given zero-based index.
 func AggregateInterface(dp *DeviceParams, index int) (string, error) {
-	n, err := lookupNamer(dp)
+	d, err := NewDevice(dp)
 	if err != nil {
 		return "", err
 	}
-	if index < 0 {
-		return "", fmt.Errorf("interface index cannot be negative: %d", index)
-	}
-	return n.AggregateInterface(uint(index))
+	return d.AggregateInterface(index)
 }
 
 // AggregateMemberInterface returns the vendor-specific name of the member
 // interface bound to the aggregate interface with the given zero-based index.
 func AggregateMemberInterface(dp *DeviceParams, index int) (string, error) {
-	n, err := lookupNamer(dp)
+	d, err := NewDevice(dp)
 	if err != nil {
 		return "", err
 	}
-	if index < 0 {
-		return "", fmt.Errorf("interface index cannot be negative: %d", index)
-	}
-	return n.AggregateMemberInterface(uint(index))
+	return d.AggregateMemberInterface(index)
 }
 
 // Port returns the vendor-specific name of the physical interface with the
 // given port parameters.
 func Port(dp *DeviceParams, pp *PortParams) (string, error) {
-	n, err := lookupNamer(dp)
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.Port(pp)
+}
+
+// BreakoutChannels returns the vendor-specific names of the channels of the
+// physical interface with the given port parameters when it is broken out
+// with the given breakout mode, ordered by channel index. The channel index
+// and breakout mode of the port parameters are ignored, and so is the channel
+// state, except that an Unchannelizable port cannot be broken out and returns
+// an error wrapping ErrInvalidBreakout. On vendors that cannot name broken out
+// channels, such as Ciena, it returns an error wrapping ErrUnsupportedEntity.
+func BreakoutChannels(dp *DeviceParams, pp *PortParams, mode BreakoutMode) ([]string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return nil, err
+	}
+	return d.BreakoutChannels(pp, mode)
+}
+
+// ParsePort returns the port parameters of the physical interface with the
+// given vendor-specific name. Port names do not encode every parameter, so it
+// is not a true inverse of the Port function: the Speed and Breakout of the
+// result are unset if the vendor's port names do not encode them, the channel
+// index of a name that does not encode its breakout mode is that of the
+// channel in the finest breakout the name can express, such as one lane per
+// channel, and names that the vendor uses for both an unchannelized port and
+// one of its channels are parsed as the unchannelized port. Once the caller
+// sets an unset Speed to the speed of the port, Port returns the name again.
+func ParsePort(dp *DeviceParams, name string) (*PortParams, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return nil, err
+	}
+	return d.ParsePort(name)
+}
+
+// Transceiver returns the vendor-specific name of the transceiver component
+// of the physical interface with the given port parameters. The channel index
+// of the port is ignored, so all channels of a port share a transceiver.
+func Transceiver(dp *DeviceParams, pp *PortParams) (string, error) {
+	d, err := NewDevice(dp)
 	if err != nil {
 		return "", err
 	}
-	npp, err := namerPortParams(pp, n.IsFixedFormFactor())
+	return d.Transceiver(pp)
+}
+
+// OpticalChannel returns the vendor-specific name of the optical channel
+// component with the given zero-based index of the physical interface with the
+// given port parameters. The channel index of the port is ignored.
+func OpticalChannel(dp *DeviceParams, pp *PortParams, index int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.OpticalChannel(pp, index)
+}
+
+// LogicalChannelKind is an enum of the kinds of terminal device logical
+// channels.
+type LogicalChannelKind = namer.LogicalChannelKind
+
+// LogicalChannelKind enum constants.
+const (
+	LogicalChannelEthernet = namer.LogicalChannelEthernet
+	LogicalChannelOTN      = namer.LogicalChannelOTN
+	LogicalChannelCoherent = namer.LogicalChannelCoherent
+)
+
+// LogicalChannelIndex returns the vendor-specific index of the terminal device
+// logical channel of the given kind that carries the physical interface with
+// the given port parameters. It is the key of the channel passed to
+// oc.TerminalDevice.NewChannel.
+func LogicalChannelIndex(dp *DeviceParams, pp *PortParams, kind LogicalChannelKind) (uint32, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return 0, err
+	}
+	return d.LogicalChannelIndex(pp, kind)
+}
+
+// PortASIC returns the vendor-specific name of the integrated circuit
+// component that serves the physical interface with the given port
+// parameters. The channel index of the port is ignored, so all channels of a
+// port share an integrated circuit.
+func PortASIC(dp *DeviceParams, pp *PortParams) (string, error) {
+	d, err := NewDevice(dp)
 	if err != nil {
 		return "", err
 	}
-	return n.Port(npp)
+	return d.PortASIC(pp)
 }
 
 func namerPortParams(pp *PortParams, fixedFormFactor bool) (*namer.PortParams, error) {
 	switch {
 	case pp.SlotIndex < 0:
-		return nil, fmt.Errorf("slot index cannot be negative: %d", pp.SlotIndex)
+		return nil, &IndexOutOfRangeError{Entity: KindSlot, Index: pp.SlotIndex, Max: -1}
 	case pp.PICIndex < 0:
-		return nil, fmt.Errorf("pic index cannot be negative: %d", pp.PICIndex)
+		return nil, &IndexOutOfRangeError{Entity: KindPIC, Index: pp.PICIndex, Max: -1}
 	case pp.PortIndex < 0:
-		return nil, fmt.Errorf("port index cannot be negative: %d", pp.PortIndex)
+		return nil, &IndexOutOfRangeError{Entity: KindPort, Index: pp.PortIndex, Max: -1}
 	case pp.ChannelIndex < 0:
-		return nil, fmt.Errorf("channel index cannot be negative: %d", pp.ChannelIndex)
+		return nil, &IndexOutOfRangeError{Entity: KindChannel, Index: pp.ChannelIndex, Max: -1}
 	case pp.SlotIndex > 0 && fixedFormFactor:
 		return nil, fmt.Errorf("cannot have a non-zero slot index on a fixed form factor device")
 	case pp.ChannelIndex > 0 && pp.ChannelState != Channelized:
 		return nil, fmt.Errorf("cannot have a non-zero channel index with an unchannelized port")
 	case pp.Speed == oc.IfEthernet_ETHERNET_SPEED_UNSET || pp.Speed == oc.IfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN:
-		return nil, fmt.Errorf("port speed cannot be unset or unknown")
+		return nil, fmt.Errorf("port speed cannot be unset or unknown: %w", ErrUnsupportedSpeed)
+	}
+	if pp.Breakout != (BreakoutMode{}) {
+		switch {
+		case pp.ChannelState != Channelized:
+			return nil, fmt.Errorf("cannot have a breakout mode with an unchannelized port: %w", ErrInvalidBreakout)
+		case pp.Breakout.NumChannels < 0:
+			return nil, fmt.Errorf("number of channels cannot be negative, got %d: %w", pp.Breakout.NumChannels, ErrInvalidBreakout)
+		case pp.Breakout.NumChannels > 0 && pp.ChannelIndex >= pp.Breakout.NumChannels:
+			return nil, fmt.Errorf("%w: %w", &IndexOutOfRangeError{Entity: KindChannel, Index: pp.ChannelIndex, Max: pp.Breakout.NumChannels - 1}, ErrInvalidBreakout)
+		case pp.Breakout.ChannelSpeed == oc.IfEthernet_ETHERNET_SPEED_UNSET || pp.Breakout.ChannelSpeed == oc.IfEthernet_ETHERNET_SPEED_SPEED_UNKNOWN:
+			return nil, fmt.Errorf("channel speed cannot be unset or unknown: %w", ErrUnsupportedSpeed)
+		}
 	}
 	npp := &namer.PortParams{
 		PICIndex:      uint(pp.PICIndex),
@@ -175,46 +313,437 @@ func namerPortParams(pp *PortParams, fixedFormFactor bool) (*namer.PortParams, e
 		channelIndex := uint(pp.ChannelIndex)
 		npp.ChannelIndex = &channelIndex
 	}
+	if pp.Breakout != (BreakoutMode{}) {
+		npp.Breakout = &namer.BreakoutMode{
+			NumChannels:  uint(pp.Breakout.NumChannels),
+			ChannelSpeed: pp.Breakout.ChannelSpeed,
+		}
+	}
 	return npp, nil
 }
 
+func portParams(npp *namer.PortParams) *PortParams {
+	pp := &PortParams{
+		PICIndex:  int(npp.PICIndex),
+		PortIndex: int(npp.PortIndex),
+		Speed:     npp.Speed,
+	}
+	if npp.SlotIndex != nil {
+		pp.SlotIndex = int(*npp.SlotIndex)
+	}
+	switch {
+	case npp.ChannelIndex != nil:
+		pp.ChannelState = Channelized
+		pp.ChannelIndex = int(*npp.ChannelIndex)
+	case !npp.Channelizable:
+		pp.ChannelState = Unchannelizable
+	}
+	if npp.Breakout != nil {
+		pp.Breakout = BreakoutMode{
+			NumChannels:  int(npp.Breakout.NumChannels),
+			ChannelSpeed: npp.Breakout.ChannelSpeed,
+		}
+	}
+	return pp
+}
+
+// Subinterface returns the vendor-specific name of the subinterface with the
+// given index of the interface with the given vendor-specific name.
+func Subinterface(dp *DeviceParams, parent string, subIndex int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.Subinterface(parent, subIndex)
+}
+
+// PortSubinterface returns the vendor-specific name of the subinterface with
+// the given index of the physical interface with the given port parameters.
+func PortSubinterface(dp *DeviceParams, pp *PortParams, subIndex int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.PortSubinterface(pp, subIndex)
+}
+
+// AggregateSubinterface returns the vendor-specific name of the subinterface
+// with the given index of the aggregate interface with the given zero-based
+// index.
+func AggregateSubinterface(dp *DeviceParams, index, subIndex int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.AggregateSubinterface(index, subIndex)
+}
+
+// LoopbackSubinterface returns the vendor-specific name of the subinterface
+// with the given index of the loopback interface with the given zero-based
+// index.
+func LoopbackSubinterface(dp *DeviceParams, index, subIndex int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.LoopbackSubinterface(index, subIndex)
+}
+
+// VLANInterface returns the vendor-specific name of the routed VLAN interface
+// with the given VLAN ID.
+func VLANInterface(dp *DeviceParams, vlanID int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.VLANInterface(vlanID)
+}
+
+// TunnelKind is an enum of the kinds of tunnels.
+type TunnelKind = namer.TunnelKind
+
+// TunnelKind enum constants.
+const (
+	TunnelGRE    = namer.TunnelGRE
+	TunnelIPinIP = namer.TunnelIPinIP
+	TunnelMPLSTE = namer.TunnelMPLSTE
+)
+
+// TunnelInterface returns the vendor-specific name of the tunnel interface of
+// the given kind with the given zero-based index. On vendors whose tunnel
+// names depend on the linecard hosting the tunnel service, the tunnel is
+// hosted by the first linecard; use LinecardTunnelInterface to choose another.
+func TunnelInterface(dp *DeviceParams, kind TunnelKind, index int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.TunnelInterface(kind, index)
+}
+
+// LinecardTunnelInterface returns the vendor-specific name of the tunnel
+// interface of the given kind with the given zero-based index, hosted by the
+// linecard with the given zero-based index.
+func LinecardTunnelInterface(dp *DeviceParams, kind TunnelKind, linecardIndex, index int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.LinecardTunnelInterface(kind, linecardIndex, index)
+}
+
+// EntityKind is an enum of the kinds of named entities.
+type EntityKind = namer.EntityKind
+
+// EntityKind enum constants.
+const (
+	KindLoopback            = namer.KindLoopback
+	KindAggregate           = namer.KindAggregate
+	KindAggregateMember     = namer.KindAggregateMember
+	KindPort                = namer.KindPort
+	KindLinecard            = namer.KindLinecard
+	KindControllerCard      = namer.KindControllerCard
+	KindFabric              = namer.KindFabric
+	KindSubinterface        = namer.KindSubinterface
+	KindVLANInterface       = namer.KindVLANInterface
+	KindTunnelInterface     = namer.KindTunnelInterface
+	KindManagementInterface = namer.KindManagementInterface
+	KindPowerSupply         = namer.KindPowerSupply
+	KindFanTray             = namer.KindFanTray
+	KindFan                 = namer.KindFan
+	KindChassis             = namer.KindChassis
+	KindBackplane           = namer.KindBackplane
+	KindIntegratedCircuit   = namer.KindIntegratedCircuit
+	KindCPU                 = namer.KindCPU
+	KindOpticalChannel      = namer.KindOpticalChannel
+	KindSlot                = namer.KindSlot
+	KindPIC                 = namer.KindPIC
+	KindChannel             = namer.KindChannel
+)
+
+// Errors returned by the naming functions, which callers can identify with
+// errors.Is.
+var (
+	// ErrUnsupportedVendor indicates that no Namer is registered for the
+	// vendor of the device.
+	ErrUnsupportedVendor = errors.New("no Namer for vendor")
+	// ErrUnsupportedHardwareModel indicates that the hardware model of the
+	// device is not supported, or that a name or limit depends on details of
+	// the hardware model that the Namer does not know.
+	ErrUnsupportedHardwareModel = namer.ErrUnsupportedHardwareModel
+	// ErrUnsupportedSpeed indicates that the port speed cannot be named.
+	ErrUnsupportedSpeed = namer.ErrUnsupportedSpeed
+	// ErrUnsupportedEntity indicates that the device has no entity of the
+	// requested kind or configuration.
+	ErrUnsupportedEntity = namer.ErrUnsupportedEntity
+	// ErrInvalidBreakout indicates that a port cannot have the breakout mode.
+	ErrInvalidBreakout = errors.New("invalid breakout mode")
+	// ErrInvalidParentInterface indicates that an interface name cannot be the
+	// parent of a subinterface.
+	ErrInvalidParentInterface = errors.New("invalid parent interface")
+)
+
+// IndexOutOfRangeError indicates that an entity index is not valid. Callers
+// can retrieve it with errors.As.
+type IndexOutOfRangeError = namer.IndexOutOfRangeError
+
+// UnsupportedSpeedError indicates that a hardware model has no ports of a
+// speed. Callers can retrieve it with errors.As.
+type UnsupportedSpeedError = namer.UnsupportedSpeedError
+
+// classifyOrder is the order in which Classify tries each kind of entity.
+var classifyOrder = []EntityKind{
+	KindLoopback,
+	KindAggregate,
+	KindAggregateMember,
+	KindPort,
+	KindLinecard,
+	KindControllerCard,
+	KindFabric,
+	KindVLANInterface,
+	KindPowerSupply,
+	KindFanTray,
+	KindChassis,
+	KindBackplane,
+}
+
+// Entity is a named entity of a network device.
+type Entity struct {
+	Kind EntityKind
+	// Index is the zero-based index of the entity. It is zero for ports.
+	Index int
+	// Port are the parameters of the port. It is nil for other kinds.
+	Port *PortParams
+}
+
+func (e *Entity) String() string {
+	if e == nil {
+		return nilString
+	}
+	return fmt.Sprintf("%+v", *e)
+}
+
+// Classify returns the kind of the entity with the given vendor-specific name,
+// together with its zero-based index or, for ports, its port parameters. A
+// name shared by more than one kind of entity is classified as the first kind
+// in the order: loopback, aggregate, aggregate member, port, linecard,
+// controller card, fabric, VLAN interface, power supply, fan tray, chassis,
+// backplane. In particular, aggregate takes precedence over aggregate member,
+// so on vendors that name an aggregate member like its aggregate, such as
+// Arista (Port-Channel12) and Cisco (Bundle-Ether7), such names are classified
+// as aggregates and KindAggregateMember is never returned. The error wraps
+// ErrUnsupportedEntity if the name is not that of any entity of the device.
+func Classify(dp *DeviceParams, name string) (*Entity, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return nil, err
+	}
+	return d.Classify(name)
+}
+
 // Linecard returns the vendor-specific name of the linecard with the given
 // zero-based index.
 func Linecard(dp *DeviceParams, index int) (string, error) {
-	n, err := lookupNamer(dp)
+	d, err := NewDevice(dp)
 	if err != nil {
 		return "", err
 	}
-	if index < 0 {
-		return "", fmt.Errorf("interface index cannot be negative: %d", index)
-	}
-	return n.Linecard(uint(index))
+	return d.Linecard(index)
 }
 
 // ControllerCard returns the vendor-specific name of the controller card with
 // the given zero-based index.
 func ControllerCard(dp *DeviceParams, index int) (string, error) {
-	n, err := lookupNamer(dp)
+	d, err := NewDevice(dp)
 	if err != nil {
 		return "", err
 	}
-	if index < 0 {
-		return "", fmt.Errorf("interface index cannot be negative: %d", index)
-	}
-	return n.ControllerCard(uint(index))
+	return d.ControllerCard(index)
 }
 
 // Fabric returns the vendor-specific name of the fabric with the given
 // zero-based index.
 func Fabric(dp *DeviceParams, index int) (string, error) {
-	n, err := lookupNamer(dp)
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.Fabric(index)
+}
+
+// AllLinecards returns an iterator over the zero-based indices and
+// vendor-specific names of every valid linecard of the device, up to the
+// limit reported by Capabilities. Indices the vendor rejects are skipped. The
+// iterator is empty if the device parameters are not supported.
+func AllLinecards(dp *DeviceParams) iter.Seq2[int, string] {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return emptySeq
+	}
+	return d.AllLinecards()
+}
+
+// AllControllerCards returns an iterator over the zero-based indices and
+// vendor-specific names of every valid controller card of the device. See the
+// AllLinecards function for details.
+func AllControllerCards(dp *DeviceParams) iter.Seq2[int, string] {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return emptySeq
+	}
+	return d.AllControllerCards()
+}
+
+// AllFabrics returns an iterator over the zero-based indices and
+// vendor-specific names of every valid fabric of the device. See the
+// AllLinecards function for details.
+func AllFabrics(dp *DeviceParams) iter.Seq2[int, string] {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return emptySeq
+	}
+	return d.AllFabrics()
+}
+
+// AllPowerSupplies returns an iterator over the zero-based indices and
+// vendor-specific names of every valid power supply of the device. See the
+// AllLinecards function for details.
+func AllPowerSupplies(dp *DeviceParams) iter.Seq2[int, string] {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return emptySeq
+	}
+	return d.AllPowerSupplies()
+}
+
+// AllFanTrays returns an iterator over the zero-based indices and
+// vendor-specific names of every valid fan tray of the device. See the
+// AllLinecards function for details.
+func AllFanTrays(dp *DeviceParams) iter.Seq2[int, string] {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return emptySeq
+	}
+	return d.AllFanTrays()
+}
+
+func emptySeq(func(int, string) bool) {}
+
+// ManagementInterface returns the vendor-specific name of the management
+// interface with the given zero-based index on the controller card with the
+// given zero-based index.
+func ManagementInterface(dp *DeviceParams, controllerCardIndex, index int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.ManagementInterface(controllerCardIndex, index)
+}
+
+// PowerSupply returns the vendor-specific name of the power supply with the
+// given zero-based index.
+func PowerSupply(dp *DeviceParams, index int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.PowerSupply(index)
+}
+
+// FanTray returns the vendor-specific name of the fan tray with the given
+// zero-based index.
+func FanTray(dp *DeviceParams, index int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.FanTray(index)
+}
+
+// Fan returns the vendor-specific name of the fan with the given zero-based
+// index in the fan tray with the given zero-based index.
+func Fan(dp *DeviceParams, trayIndex, fanIndex int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.Fan(trayIndex, fanIndex)
+}
+
+// IntegratedCircuit returns the vendor-specific name of the integrated
+// circuit with the given zero-based index on the linecard with the given
+// zero-based index. Linecard index zero is the chassis of a fixed form factor
+// device.
+func IntegratedCircuit(dp *DeviceParams, linecardIndex, chipIndex int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.IntegratedCircuit(linecardIndex, chipIndex)
+}
+
+// CPU returns the vendor-specific name of the CPU with the given zero-based
+// index on the card of the given kind with the given zero-based index. The
+// kind of the card must be KindLinecard or KindControllerCard.
+func CPU(dp *DeviceParams, cardKind EntityKind, cardIndex, cpuIndex int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.CPU(cardKind, cardIndex, cpuIndex)
+}
+
+// Chassis returns the vendor-specific name of the chassis with the given
+// zero-based index. Single-chassis devices have only chassis zero; the index
+// selects a chassis of a multi-chassis system, such as a Ciena system whose
+// slots are numbered per chassis.
+func Chassis(dp *DeviceParams, index int) (string, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return "", err
+	}
+	return d.Chassis(index)
+}
+
+// Backplane returns the vendor-specific name of the backplane of the chassis
+// with the given zero-based index.
+func Backplane(dp *DeviceParams, chassisIndex int) (string, error) {
+	d, err := NewDevice(dp)
 	if err != nil {
 		return "", err
 	}
-	if index < 0 {
-		return "", fmt.Errorf("interface index cannot be negative: %d", index)
+	return d.Backplane(chassisIndex)
+}
+
+// DeviceCapabilities are the naming limits of a device.
+type DeviceCapabilities struct {
+	// MaxLoopbacks, MaxAggregates, MaxLinecards, MaxControllerCards,
+	// MaxFabrics, MaxPowerSupplies, and MaxFanTrays are the maximum numbers of
+	// each kind of entity. On devices that span multiple chassis, they are the
+	// maximum numbers per chassis.
+	MaxLoopbacks, MaxAggregates, MaxLinecards, MaxControllerCards, MaxFabrics int
+	MaxPowerSupplies, MaxFanTrays                                             int
+	// PortSpeeds are the ethernet link speeds of the ports that can be named.
+	PortSpeeds []oc.E_IfEthernet_ETHERNET_SPEED
+	// FixedFormFactor indicates whether the device has a fixed form factor.
+	FixedFormFactor bool
+}
+
+func (dc *DeviceCapabilities) String() string {
+	if dc == nil {
+		return nilString
+	}
+	return fmt.Sprintf("%+v", *dc)
+}
+
+// Capabilities returns the naming limits of the device, so that callers can
+// check the entities they intend to name before calling the naming functions.
+func Capabilities(dp *DeviceParams) (*DeviceCapabilities, error) {
+	d, err := NewDevice(dp)
+	if err != nil {
+		return nil, err
 	}
-	return n.Fabric(uint(index))
+	return d.Capabilities()
 }
 
 // QoSClass represents a common QoS class.
@@ -271,27 +800,11 @@ type QoSParams struct {
 // QoS classes. See the common QoS class definitions here:
 // https://github.com/openconfig/entity-naming/blob/main/README.md#common-qos-queues
 func CommonQoSQueues(dev *DeviceParams, qos *QoSParams) (*CommonQoSQueueNames, error) {
-	n, err := lookupNamer(dev)
-	if err != nil {
-		return nil, err
-	}
-	nqp, err := namerQoSParams(qos)
-	if err != nil {
-		return nil, err
-	}
-	cqq, err := n.CommonQoSQueues(nqp)
+	d, err := NewDevice(dev)
 	if err != nil {
 		return nil, err
 	}
-	return &CommonQoSQueueNames{map[QoSClass]string{
-		QoSNC1: cqq.NC1,
-		QoSAF4: cqq.AF4,
-		QoSAF3: cqq.AF3,
-		QoSAF2: cqq.AF2,
-		QoSAF1: cqq.AF1,
-		QoSBE1: cqq.BE1,
-		QoSBE0: cqq.BE0,
-	}}, nil
+	return d.CommonQoSQueues(qos)
 }
 
 func namerQoSParams(qos *QoSParams) (*namer.QoSParams, error) {
@@ -323,11 +836,11 @@ func (qn *CommonTrafficQueueNames) String() string {
 // class queues. See the forwarding group definitions here:
 // Deprecated: Use the CommonQoSQueues function instead.
 func CommonTrafficQueues(dev *DeviceParams) (*CommonTrafficQueueNames, error) {
-	n, err := lookupNamer(dev)
+	d, err := NewDevice(dev)
 	if err != nil {
 		return nil, err
 	}
-	cqq, err := n.CommonQoSQueues(&namer.QoSParams{})
+	cqq, err := d.namer.CommonQoSQueues(&namer.QoSParams{})
 	if err != nil {
 		return nil, err
 	}
@@ -343,9 +856,11 @@ func CommonTrafficQueues(dev *DeviceParams) (*CommonTrafficQueueNames, error) {
 }
 
 func lookupNamer(dp *DeviceParams) (namer.Namer, error) {
+	namerFactoriesMu.RLock()
 	nf, ok := namerFactories[dp.Vendor]
+	namerFactoriesMu.RUnlock()
 	if !ok {
-		return nil, fmt.Errorf("no Namer for vendor %v", dp.Vendor)
+		return nil, fmt.Errorf("%w %v", ErrUnsupportedVendor, dp.Vendor)
 	}
 	return nf(dp.HardwareModel), nil
 }
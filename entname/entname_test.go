@@ -16,10 +16,14 @@ package entname
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
 
-	"github.com/openconfig/entity-naming/internal/namer"
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/entity-naming/namer"
 	"github.com/openconfig/entity-naming/oc"
 )
 
@@ -50,6 +54,10 @@ func TestLoopbackInterface(t *testing.T) {
 		if wantErr := "negative"; err == nil || !strings.Contains(err.Error(), wantErr) {
 			t.Errorf("LoopbackInterface(%v,0) got error %v, want substring %q", devParams, err, wantErr)
 		}
+		var rangeErr *IndexOutOfRangeError
+		if !errors.As(err, &rangeErr) || rangeErr.Entity != KindLoopback || rangeErr.Index != -1 {
+			t.Errorf("LoopbackInterface(%v,-1) got error %v, want an IndexOutOfRangeError for index -1", devParams, err)
+		}
 	})
 
 	t.Run("error", func(t *testing.T) {
@@ -248,6 +256,192 @@ func TestFabric(t *testing.T) {
 	})
 }
 
+func TestVLANInterface(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		const want = "fakeVLAN100"
+		setFakeNamer(&fakeNamer{VLANInterfaceFn: func(uint) (string, error) {
+			return want, nil
+		}})
+		got, err := VLANInterface(devParams, 100)
+		if err != nil {
+			t.Errorf("VLANInterface(%v,100) got error %v", devParams, err)
+		}
+		if got != want {
+			t.Errorf("VLANInterface(%v,100) got %q, want %q", devParams, got, want)
+		}
+	})
+
+	t.Run("negative VLAN ID", func(t *testing.T) {
+		setFakeNamer(&fakeNamer{VLANInterfaceFn: func(uint) (string, error) {
+			return "", nil
+		}})
+		_, err := VLANInterface(devParams, -1)
+		if wantErr := "negative"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("VLANInterface(%v,-1) got error %v, want substring %q", devParams, err, wantErr)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		const wantErr = "VLANInterfaceErr"
+		setFakeNamer(&fakeNamer{VLANInterfaceFn: func(uint) (string, error) {
+			return "", errors.New(wantErr)
+		}})
+		_, err := VLANInterface(devParams, 100)
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("VLANInterface(%v,100) got error %v, want substring %q", devParams, err, wantErr)
+		}
+	})
+}
+
+func TestTunnelInterface(t *testing.T) {
+	fake := &fakeNamer{TunnelInterfaceFn: func(tp *namer.TunnelParams) (string, error) {
+		return fmt.Sprintf("%s-%d-%d", tp.Kind, tp.LinecardIndex, tp.Index), nil
+	}}
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr string
+		wantIs  error
+	}{{
+		desc:   "first linecard",
+		nameFn: func() (string, error) { return TunnelInterface(devParams, TunnelGRE, 3) },
+		want:   "GRE-0-3",
+	}, {
+		desc:   "given linecard",
+		nameFn: func() (string, error) { return LinecardTunnelInterface(devParams, TunnelMPLSTE, 2, 3) },
+		want:   "MPLS-TE-2-3",
+	}, {
+		desc:    "unknown kind",
+		nameFn:  func() (string, error) { return TunnelInterface(devParams, TunnelKind("VXLAN"), 0) },
+		wantErr: "unknown tunnel kind",
+		wantIs:  ErrUnsupportedEntity,
+	}, {
+		desc:    "negative index",
+		nameFn:  func() (string, error) { return TunnelInterface(devParams, TunnelGRE, -1) },
+		wantErr: "negative",
+	}, {
+		desc:    "negative linecard index",
+		nameFn:  func() (string, error) { return LinecardTunnelInterface(devParams, TunnelGRE, -1, 0) },
+		wantErr: "negative",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			setFakeNamer(fake)
+			got, err := test.nameFn()
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("got error %v, want substring %q", err, test.wantErr)
+				}
+				if test.wantIs != nil && !errors.Is(err, test.wantIs) {
+					t.Errorf("got error %v, want %v", err, test.wantIs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got error %v", err)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSubinterface(t *testing.T) {
+	fake := &fakeNamer{
+		SubinterfaceFn: func(parent string, index uint) (string, error) {
+			return fmt.Sprintf("%s.%d", parent, index), nil
+		},
+		LoopbackInterfaceFn: func(index uint) (string, error) {
+			return fmt.Sprintf("fakeLoopback%d", index), nil
+		},
+		AggregateInterfaceFn: func(index uint) (string, error) {
+			return fmt.Sprintf("fakeAggregate%d", index), nil
+		},
+		PortFn: func(*namer.PortParams) (string, error) {
+			return "fakePort", nil
+		},
+		IsFixedFormFactorFn: func() bool {
+			return false
+		},
+	}
+	pp := &PortParams{Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB}
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr string
+		wantIs  error
+	}{{
+		desc:   "subinterface",
+		nameFn: func() (string, error) { return Subinterface(devParams, "fakeParent", 100) },
+		want:   "fakeParent.100",
+	}, {
+		desc:   "port subinterface",
+		nameFn: func() (string, error) { return PortSubinterface(devParams, pp, 1) },
+		want:   "fakePort.1",
+	}, {
+		desc:   "aggregate subinterface",
+		nameFn: func() (string, error) { return AggregateSubinterface(devParams, 2, 3) },
+		want:   "fakeAggregate2.3",
+	}, {
+		desc:   "loopback subinterface",
+		nameFn: func() (string, error) { return LoopbackSubinterface(devParams, 0, 0) },
+		want:   "fakeLoopback0.0",
+	}, {
+		desc:    "empty parent",
+		nameFn:  func() (string, error) { return Subinterface(devParams, "", 1) },
+		wantErr: "empty",
+		wantIs:  ErrInvalidParentInterface,
+	}, {
+		desc:    "parent is a subinterface",
+		nameFn:  func() (string, error) { return Subinterface(devParams, "fakeParent.1", 1) },
+		wantErr: "subinterface",
+		wantIs:  ErrInvalidParentInterface,
+	}, {
+		desc:    "negative index",
+		nameFn:  func() (string, error) { return Subinterface(devParams, "fakeParent", -1) },
+		wantErr: "negative",
+	}, {
+		desc:    "negative aggregate index",
+		nameFn:  func() (string, error) { return AggregateSubinterface(devParams, -1, 1) },
+		wantErr: "negative",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			setFakeNamer(fake)
+			got, err := test.nameFn()
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("got error %v, want substring %q", err, test.wantErr)
+				}
+				if test.wantIs != nil && !errors.Is(err, test.wantIs) {
+					t.Errorf("got error %v, want %v", err, test.wantIs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got error %v", err)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		const wantErr = "fakeSubinterfaceErr"
+		setFakeNamer(&fakeNamer{SubinterfaceFn: func(string, uint) (string, error) {
+			return "", errors.New(wantErr)
+		}})
+		_, err := Subinterface(devParams, "fakeParent", 1)
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("Subinterface(%v,%q,1) got error %v, want substring %q", devParams, "fakeParent", err, wantErr)
+		}
+	})
+}
+
 func TestPort(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		const want = "fakePort"
@@ -308,22 +502,35 @@ func TestPort(t *testing.T) {
 		portParams *PortParams
 		fixedForm  bool
 		wantErr    string
+		// wantIs is the sentinel error that the error wraps, if any.
+		wantIs error
+		// wantRange is the kind of the IndexOutOfRangeError, if any.
+		wantRange EntityKind
 	}{{
 		desc:       "negative slot",
 		portParams: &PortParams{SlotIndex: -1, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB},
 		wantErr:    "slot",
+		wantRange:  KindSlot,
 	}, {
 		desc:       "negative pic",
 		portParams: &PortParams{PICIndex: -2, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB},
-		wantErr:    "pic",
+		wantErr:    "PIC",
+		wantRange:  KindPIC,
 	}, {
 		desc:       "negative port",
 		portParams: &PortParams{PortIndex: -3, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB},
 		wantErr:    "port",
+		wantRange:  KindPort,
 	}, {
 		desc:       "negative channel",
 		portParams: &PortParams{ChannelIndex: -4, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB},
 		wantErr:    "channel",
+		wantRange:  KindChannel,
+	}, {
+		desc:       "unset port speed",
+		portParams: &PortParams{},
+		wantErr:    "port speed",
+		wantIs:     ErrUnsupportedSpeed,
 	}, {
 		desc:       "non-zero slot on fixed form factor",
 		portParams: &PortParams{SlotIndex: 1, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB},
@@ -339,6 +546,43 @@ func TestPort(t *testing.T) {
 		portParams: &PortParams{ChannelIndex: 1, ChannelState: Unchannelizable, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB},
 		fixedForm:  true,
 		wantErr:    "non-zero channel",
+	}, {
+		desc: "breakout mode on unchannelized port",
+		portParams: &PortParams{
+			Speed:    oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+			Breakout: BreakoutMode{NumChannels: 4, ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+		},
+		wantErr: "breakout mode",
+		wantIs:  ErrInvalidBreakout,
+	}, {
+		desc: "negative number of channels",
+		portParams: &PortParams{
+			ChannelState: Channelized,
+			Speed:        oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+			Breakout:     BreakoutMode{NumChannels: -1, ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+		},
+		wantErr: "number of channels",
+		wantIs:  ErrInvalidBreakout,
+	}, {
+		desc: "channel index out of breakout range",
+		portParams: &PortParams{
+			ChannelIndex: 4,
+			ChannelState: Channelized,
+			Speed:        oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+			Breakout:     BreakoutMode{NumChannels: 4, ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+		},
+		wantErr:   "cannot exceed 3",
+		wantIs:    ErrInvalidBreakout,
+		wantRange: KindChannel,
+	}, {
+		desc: "unset channel speed",
+		portParams: &PortParams{
+			ChannelState: Channelized,
+			Speed:        oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+			Breakout:     BreakoutMode{NumChannels: 4},
+		},
+		wantErr: "channel speed",
+		wantIs:  ErrUnsupportedSpeed,
 	}}
 	for _, test := range badParamsTests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -354,10 +598,892 @@ func TestPort(t *testing.T) {
 			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
 				t.Errorf("Port(%v,{}) got error %v, want substring %q", devParams, err, test.wantErr)
 			}
+			if test.wantIs != nil && !errors.Is(err, test.wantIs) {
+				t.Errorf("Port(%v,{}) got error %v, want %v", devParams, err, test.wantIs)
+			}
+			var rangeErr *IndexOutOfRangeError
+			if test.wantRange != "" && (!errors.As(err, &rangeErr) || rangeErr.Entity != test.wantRange) {
+				t.Errorf("Port(%v,{}) got error %v, want an IndexOutOfRangeError for %s", devParams, err, test.wantRange)
+			}
+		})
+	}
+
+	t.Run("breakout mode", func(t *testing.T) {
+		want := &namer.BreakoutMode{NumChannels: 2, ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_200GB}
+		setFakeNamer(&fakeNamer{
+			PortFn: func(npp *namer.PortParams) (string, error) {
+				if diff := cmp.Diff(want, npp.Breakout); diff != "" {
+					t.Errorf("Port() got unexpected breakout mode diff (-want +got):\n%s", diff)
+				}
+				return "fakePort", nil
+			},
+			IsFixedFormFactorFn: func() bool {
+				return false
+			},
+		})
+		pp := &PortParams{
+			ChannelIndex: 1,
+			ChannelState: Channelized,
+			Speed:        oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+			Breakout:     BreakoutMode{NumChannels: 2, ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_200GB},
+		}
+		if _, err := Port(devParams, pp); err != nil {
+			t.Errorf("Port(%v,%v) got error %v", devParams, pp, err)
+		}
+	})
+
+	t.Run("built-in vendor hardware models", func(t *testing.T) {
+		pp := &PortParams{PortIndex: 1, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB}
+		fixed := &DeviceParams{Vendor: VendorArista, HardwareModel: "7060CX-32S"}
+		if got, err := Port(fixed, pp); err != nil || got != "Ethernet1/1" {
+			t.Errorf("Port(%v,%v) got (%q, %v), want %q", fixed, pp, got, err, "Ethernet1/1")
+		}
+		modular := &DeviceParams{Vendor: VendorArista, HardwareModel: "7800R3"}
+		if got, err := Port(modular, pp); err != nil || got != "Ethernet3/1/1" {
+			t.Errorf("Port(%v,%v) got (%q, %v), want %q", modular, pp, got, err, "Ethernet3/1/1")
+		}
+		slotPP := &PortParams{SlotIndex: 1, PortIndex: 1, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB}
+		if _, err := Port(fixed, slotPP); err == nil {
+			t.Errorf("Port(%v,%v) got no error, want error for a non-zero slot", fixed, slotPP)
+		}
+	})
+}
+
+func TestBreakoutChannels(t *testing.T) {
+	setFakeNamer(&fakeNamer{
+		PortFn: func(pp *namer.PortParams) (string, error) {
+			if pp.ChannelIndex == nil {
+				return fmt.Sprintf("fakePort%d", pp.PortIndex), nil
+			}
+			return fmt.Sprintf("fakePort%d:%d@%v", pp.PortIndex, *pp.ChannelIndex, pp.Breakout.ChannelSpeed), nil
+		},
+		IsFixedFormFactorFn: func() bool {
+			return true
+		},
+	})
+	pp := &PortParams{PortIndex: 1, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB}
+	mode := BreakoutMode{NumChannels: 2, ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_200GB}
+
+	t.Run("success", func(t *testing.T) {
+		got, err := BreakoutChannels(devParams, pp, mode)
+		if err != nil {
+			t.Fatalf("BreakoutChannels(%v,%v,%v) got error %v", devParams, pp, mode, err)
+		}
+		want := []string{"fakePort1:0@SPEED_200GB", "fakePort1:1@SPEED_200GB"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("BreakoutChannels(%v,%v,%v) got unexpected diff (-want +got):\n%s", devParams, pp, mode, diff)
+		}
+	})
+
+	t.Run("channel state ignored", func(t *testing.T) {
+		channel := &PortParams{
+			PortIndex:    1,
+			ChannelIndex: 3,
+			ChannelState: Channelized,
+			Speed:        oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+			Breakout:     BreakoutMode{NumChannels: 8, ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_50GB},
+		}
+		got, err := BreakoutChannels(devParams, channel, mode)
+		if err != nil {
+			t.Fatalf("BreakoutChannels(%v,%v,%v) got error %v", devParams, channel, mode, err)
+		}
+		want := []string{"fakePort1:0@SPEED_200GB", "fakePort1:1@SPEED_200GB"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("BreakoutChannels(%v,%v,%v) got unexpected diff (-want +got):\n%s", devParams, channel, mode, diff)
+		}
+	})
+
+	badTests := []struct {
+		desc    string
+		pp      *PortParams
+		mode    BreakoutMode
+		wantErr string
+		wantIs  error
+	}{{
+		desc:    "no channels",
+		pp:      pp,
+		mode:    BreakoutMode{ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_200GB},
+		wantErr: "number of channels",
+		wantIs:  ErrInvalidBreakout,
+	}, {
+		desc:    "unchannelizable port",
+		pp:      &PortParams{PortIndex: 1, ChannelState: Unchannelizable, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB},
+		mode:    mode,
+		wantErr: "unchannelizable",
+		wantIs:  ErrInvalidBreakout,
+	}, {
+		desc:    "unset channel speed",
+		pp:      pp,
+		mode:    BreakoutMode{NumChannels: 2},
+		wantErr: "channel speed",
+		wantIs:  ErrUnsupportedSpeed,
+	}}
+	for _, test := range badTests {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := BreakoutChannels(devParams, test.pp, test.mode)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("BreakoutChannels(%v,%v,%v) got error %v, want substring %q", devParams, test.pp, test.mode, err, test.wantErr)
+			}
+			if !errors.Is(err, test.wantIs) {
+				t.Errorf("BreakoutChannels(%v,%v,%v) got error %v, want %v", devParams, test.pp, test.mode, err, test.wantIs)
+			}
+		})
+	}
+
+	t.Run("built-in vendors", func(t *testing.T) {
+		mode := BreakoutMode{NumChannels: 4, ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB}
+		tests := []struct {
+			dp   *DeviceParams
+			want []string
+		}{{
+			dp:   &DeviceParams{Vendor: VendorJuniper, HardwareModel: "PTX10001-36MR"},
+			want: []string{"et-0/0/1:0", "et-0/0/1:1", "et-0/0/1:2", "et-0/0/1:3"},
+		}, {
+			dp:   &DeviceParams{Vendor: VendorNokia, HardwareModel: "7220 IXR-D3"},
+			want: []string{"et-1/2/1", "et-1/2/2", "et-1/2/3", "et-1/2/4"},
+		}, {
+			dp:   &DeviceParams{Vendor: VendorCisco, HardwareModel: "8201-32FH"},
+			want: []string{"HundredGigE0/0/0/1/0", "HundredGigE0/0/0/1/1", "HundredGigE0/0/0/1/2", "HundredGigE0/0/0/1/3"},
+		}}
+		for _, test := range tests {
+			got, err := BreakoutChannels(test.dp, pp, mode)
+			if err != nil {
+				t.Errorf("BreakoutChannels(%v,%v,%v) got error %v", test.dp, pp, mode, err)
+				continue
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("BreakoutChannels(%v,%v,%v) got unexpected diff (-want +got):\n%s", test.dp, pp, mode, diff)
+			}
+		}
+	})
+
+	// Arista is left out because its first channel keeps the name of the port.
+	t.Run("channels never named like parent", func(t *testing.T) {
+		mode := BreakoutMode{NumChannels: 4, ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB}
+		for _, dp := range []*DeviceParams{
+			{Vendor: VendorCisco},
+			{Vendor: VendorJuniper},
+			{Vendor: VendorNokia},
+		} {
+			pp := &PortParams{SlotIndex: 1, PortIndex: 3, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB}
+			parent, err := Port(dp, pp)
+			if err != nil {
+				t.Fatalf("Port(%v,%v) got error %v", dp, pp, err)
+			}
+			got, err := BreakoutChannels(dp, pp, mode)
+			if err != nil {
+				t.Errorf("BreakoutChannels(%v,%v,%v) got error %v", dp, pp, mode, err)
+			}
+			if slices.Contains(got, parent) {
+				t.Errorf("BreakoutChannels(%v,%v,%v) got %q, which contains the parent port name %q", dp, pp, mode, got, parent)
+			}
+		}
+	})
+
+	t.Run("ciena", func(t *testing.T) {
+		dp := &DeviceParams{Vendor: VendorCiena}
+		pp := &PortParams{SlotIndex: 1, PortIndex: 3, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB}
+		if _, err := BreakoutChannels(dp, pp, mode); !errors.Is(err, ErrUnsupportedEntity) {
+			t.Errorf("BreakoutChannels(%v,%v,%v) got error %v, want %v", dp, pp, mode, err, ErrUnsupportedEntity)
+		}
+	})
+}
+
+func TestTransceiver(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		setFakeNamer(&fakeNamer{
+			TransceiverFn: func(pp *namer.PortParams) (string, error) {
+				return fmt.Sprintf("fakeXcvr%d/%d", *pp.SlotIndex, pp.PortIndex), nil
+			},
+			IsFixedFormFactorFn: func() bool {
+				return false
+			},
+		})
+		pp := &PortParams{SlotIndex: 1, PortIndex: 2, ChannelIndex: 3, ChannelState: Channelized, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB}
+		got, err := Transceiver(devParams, pp)
+		if err != nil {
+			t.Fatalf("Transceiver(%v,%v) got error %v", devParams, pp, err)
+		}
+		if want := "fakeXcvr1/2"; got != want {
+			t.Errorf("Transceiver(%v,%v) got %q, want %q", devParams, pp, got, want)
+		}
+	})
+
+	t.Run("bad port params", func(t *testing.T) {
+		setFakeNamer(&fakeNamer{IsFixedFormFactorFn: func() bool {
+			return true
+		}})
+		pp := &PortParams{SlotIndex: 1, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB}
+		_, err := Transceiver(devParams, pp)
+		if wantErr := "non-zero slot"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("Transceiver(%v,%v) got error %v, want substring %q", devParams, pp, err, wantErr)
+		}
+	})
+}
+
+func TestOpticalChannel(t *testing.T) {
+	setFakeNamer(&fakeNamer{
+		OpticalChannelFn: func(pp *namer.PortParams, index uint) (string, error) {
+			return fmt.Sprintf("fakeOCh%d/%d/%d", *pp.SlotIndex, pp.PortIndex, index), nil
+		},
+		IsFixedFormFactorFn: func() bool {
+			return false
+		},
+	})
+	pp := &PortParams{SlotIndex: 1, PortIndex: 2, ChannelState: Unchannelized, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB}
+
+	t.Run("success", func(t *testing.T) {
+		got, err := OpticalChannel(devParams, pp, 1)
+		if err != nil {
+			t.Fatalf("OpticalChannel(%v,%v,1) got error %v", devParams, pp, err)
+		}
+		if want := "fakeOCh1/2/1"; got != want {
+			t.Errorf("OpticalChannel(%v,%v,1) got %q, want %q", devParams, pp, got, want)
+		}
+	})
+
+	t.Run("negative index", func(t *testing.T) {
+		_, err := OpticalChannel(devParams, pp, -1)
+		if wantErr := "negative"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("OpticalChannel(%v,%v,-1) got error %v, want substring %q", devParams, pp, err, wantErr)
+		}
+	})
+}
+
+func TestLogicalChannelIndex(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		setFakeNamer(&fakeNamer{
+			LogicalChannelIndexFn: func(pp *namer.PortParams, kind namer.LogicalChannelKind) (uint32, error) {
+				if kind != LogicalChannelOTN {
+					return 0, fmt.Errorf("got kind %v, want %v", kind, LogicalChannelOTN)
+				}
+				return uint32(*pp.SlotIndex*100 + pp.PortIndex), nil
+			},
+			IsFixedFormFactorFn: func() bool {
+				return false
+			},
+		})
+		pp := &PortParams{SlotIndex: 1, PortIndex: 2, ChannelState: Unchannelized, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB}
+		got, err := LogicalChannelIndex(devParams, pp, LogicalChannelOTN)
+		if err != nil {
+			t.Fatalf("LogicalChannelIndex(%v,%v,%v) got error %v", devParams, pp, LogicalChannelOTN, err)
+		}
+		if want := uint32(102); got != want {
+			t.Errorf("LogicalChannelIndex(%v,%v,%v) got %d, want %d", devParams, pp, LogicalChannelOTN, got, want)
+		}
+	})
+
+	t.Run("bad port params", func(t *testing.T) {
+		setFakeNamer(&fakeNamer{IsFixedFormFactorFn: func() bool {
+			return true
+		}})
+		pp := &PortParams{SlotIndex: 1, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB}
+		_, err := LogicalChannelIndex(devParams, pp, LogicalChannelOTN)
+		if wantErr := "non-zero slot"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("LogicalChannelIndex(%v,%v,%v) got error %v, want substring %q", devParams, pp, LogicalChannelOTN, err, wantErr)
+		}
+	})
+}
+
+func TestPortASIC(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		setFakeNamer(&fakeNamer{
+			PortASICFn: func(pp *namer.PortParams) (string, error) {
+				return fmt.Sprintf("fakeChip%d/%d", *pp.SlotIndex, pp.PortIndex/4), nil
+			},
+			IsFixedFormFactorFn: func() bool {
+				return false
+			},
+		})
+		pp := &PortParams{SlotIndex: 1, PortIndex: 9, ChannelIndex: 3, ChannelState: Channelized, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB}
+		got, err := PortASIC(devParams, pp)
+		if err != nil {
+			t.Fatalf("PortASIC(%v,%v) got error %v", devParams, pp, err)
+		}
+		if want := "fakeChip1/2"; got != want {
+			t.Errorf("PortASIC(%v,%v) got %q, want %q", devParams, pp, got, want)
+		}
+	})
+
+	t.Run("bad port params", func(t *testing.T) {
+		setFakeNamer(&fakeNamer{IsFixedFormFactorFn: func() bool {
+			return true
+		}})
+		pp := &PortParams{SlotIndex: 1, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB}
+		_, err := PortASIC(devParams, pp)
+		if wantErr := "non-zero slot"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("PortASIC(%v,%v) got error %v, want substring %q", devParams, pp, err, wantErr)
+		}
+	})
+}
+
+func TestParsePort(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc string
+		npp  *namer.PortParams
+		want *PortParams
+	}{{
+		desc: "unchannelizable",
+		npp:  &namer.PortParams{SlotIndex: uintPtr(1), PICIndex: 2, PortIndex: 3},
+		want: &PortParams{SlotIndex: 1, PICIndex: 2, PortIndex: 3, ChannelState: Unchannelizable},
+	}, {
+		desc: "unchannelized",
+		npp:  &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 3, Channelizable: true},
+		want: &PortParams{SlotIndex: 1, PortIndex: 3, ChannelState: Unchannelized},
+	}, {
+		desc: "channelized",
+		npp: &namer.PortParams{
+			SlotIndex:     uintPtr(1),
+			PortIndex:     3,
+			ChannelIndex:  uintPtr(4),
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+		},
+		want: &PortParams{
+			SlotIndex:    1,
+			PortIndex:    3,
+			ChannelIndex: 4,
+			ChannelState: Channelized,
+			Speed:        oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+		},
+	}, {
+		desc: "channelized with breakout mode",
+		npp: &namer.PortParams{
+			SlotIndex:     uintPtr(1),
+			PortIndex:     3,
+			ChannelIndex:  uintPtr(4),
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+			Breakout:      &namer.BreakoutMode{ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+		},
+		want: &PortParams{
+			SlotIndex:    1,
+			PortIndex:    3,
+			ChannelIndex: 4,
+			ChannelState: Channelized,
+			Speed:        oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+			Breakout:     BreakoutMode{ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+		},
+	}, {
+		desc: "fixed form factor",
+		npp:  &namer.PortParams{PortIndex: 3, Channelizable: true},
+		want: &PortParams{PortIndex: 3, ChannelState: Unchannelized},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			setFakeNamer(&fakeNamer{ParsePortFn: func(string) (*namer.PortParams, error) {
+				return test.npp, nil
+			}})
+			got, err := ParsePort(devParams, "fakePort")
+			if err != nil {
+				t.Fatalf("ParsePort(%v,fakePort) got error %v", devParams, err)
+			}
+			if *got != *test.want {
+				t.Errorf("ParsePort(%v,fakePort) got %v, want %v", devParams, got, test.want)
+			}
+		})
+	}
+
+	t.Run("error", func(t *testing.T) {
+		const wantErr = "ParsePortErr"
+		setFakeNamer(&fakeNamer{ParsePortFn: func(string) (*namer.PortParams, error) {
+			return nil, errors.New(wantErr)
+		}})
+		_, err := ParsePort(devParams, "fakePort")
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("ParsePort(%v,fakePort) got error %v, want substring %q", devParams, err, wantErr)
+		}
+	})
+}
+
+func TestClassify(t *testing.T) {
+	fake := &fakeNamer{
+		ParsePortFn: func(name string) (*namer.PortParams, error) {
+			if name != "fakePort3" {
+				return nil, errors.New("not a port")
+			}
+			return &namer.PortParams{PortIndex: 3, Channelizable: true}, nil
+		},
+		ParseIndexFn: func(kind namer.EntityKind, name string) (uint, error) {
+			switch {
+			case kind == KindAggregate && name == "fakeAggregate2":
+				return 2, nil
+			case kind == KindAggregateMember && (name == "fakeAggregate2" || name == "fakeMember1"):
+				return 1, nil
+			case kind == KindFabric && name == "fakeFabric5":
+				return 5, nil
+			}
+			return 0, errors.New("no match")
+		},
+	}
+
+	tests := []struct {
+		desc string
+		name string
+		want *Entity
+	}{{
+		desc: "aggregate",
+		name: "fakeAggregate2",
+		want: &Entity{Kind: KindAggregate, Index: 2},
+	}, {
+		desc: "aggregate member",
+		name: "fakeMember1",
+		want: &Entity{Kind: KindAggregateMember, Index: 1},
+	}, {
+		desc: "fabric",
+		name: "fakeFabric5",
+		want: &Entity{Kind: KindFabric, Index: 5},
+	}, {
+		desc: "port",
+		name: "fakePort3",
+		want: &Entity{Kind: KindPort, Port: &PortParams{PortIndex: 3}},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			setFakeNamer(fake)
+			got, err := Classify(devParams, test.name)
+			if err != nil {
+				t.Fatalf("Classify(%v,%q) got error %v", devParams, test.name, err)
+			}
+			if got.Kind != test.want.Kind || got.Index != test.want.Index {
+				t.Errorf("Classify(%v,%q) got %v, want %v", devParams, test.name, got, test.want)
+			}
+			if (got.Port == nil) != (test.want.Port == nil) || (got.Port != nil && *got.Port != *test.want.Port) {
+				t.Errorf("Classify(%v,%q) got port %v, want %v", devParams, test.name, got.Port, test.want.Port)
+			}
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		setFakeNamer(fake)
+		_, err := Classify(devParams, "fakeUnknown")
+		if wantErr := "fakeUnknown"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("Classify(%v,fakeUnknown) got error %v, want substring %q", devParams, err, wantErr)
+		}
+		if !errors.Is(err, ErrUnsupportedEntity) {
+			t.Errorf("Classify(%v,fakeUnknown) got error %v, want %v", devParams, err, ErrUnsupportedEntity)
+		}
+	})
+
+	t.Run("aggregate named like its member", func(t *testing.T) {
+		for _, test := range []struct {
+			dp   *DeviceParams
+			name string
+		}{
+			{&DeviceParams{Vendor: VendorArista}, "Port-Channel12"},
+			{&DeviceParams{Vendor: VendorCisco}, "Bundle-Ether7"},
+		} {
+			member, err := AggregateMemberInterface(test.dp, 0)
+			if err != nil {
+				t.Fatalf("AggregateMemberInterface(%v,0) got error %v", test.dp, err)
+			}
+			got, err := Classify(test.dp, test.name)
+			if err != nil {
+				t.Fatalf("Classify(%v,%q) got error %v", test.dp, test.name, err)
+			}
+			if got.Kind != KindAggregate {
+				t.Errorf("Classify(%v,%q) got kind %v, want %v", test.dp, test.name, got.Kind, KindAggregate)
+			}
+			if memberEntity, err := Classify(test.dp, member); err != nil || memberEntity.Kind != KindAggregate {
+				t.Errorf("Classify(%v,%q) got %v, %v, want kind %v", test.dp, member, memberEntity, err, KindAggregate)
+			}
+		}
+	})
+}
+
+func TestManagementInterface(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		setFakeNamer(&fakeNamer{ManagementInterfaceFn: func(cc, index uint) (string, error) {
+			return fmt.Sprintf("fakeMgmt%d/%d", cc, index), nil
+		}})
+		got, err := ManagementInterface(devParams, 1, 2)
+		if err != nil {
+			t.Errorf("ManagementInterface(%v,1,2) got error %v", devParams, err)
+		}
+		if want := "fakeMgmt1/2"; got != want {
+			t.Errorf("ManagementInterface(%v,1,2) got %q, want %q", devParams, got, want)
+		}
+	})
+
+	t.Run("negative index", func(t *testing.T) {
+		setFakeNamer(&fakeNamer{ManagementInterfaceFn: func(uint, uint) (string, error) {
+			return "", nil
+		}})
+		for _, indices := range [][2]int{{-1, 0}, {0, -1}} {
+			_, err := ManagementInterface(devParams, indices[0], indices[1])
+			if wantErr := "negative"; err == nil || !strings.Contains(err.Error(), wantErr) {
+				t.Errorf("ManagementInterface(%v,%d,%d) got error %v, want substring %q", devParams, indices[0], indices[1], err, wantErr)
+			}
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		const wantErr = "ManagementInterfaceErr"
+		setFakeNamer(&fakeNamer{ManagementInterfaceFn: func(uint, uint) (string, error) {
+			return "", errors.New(wantErr)
+		}})
+		_, err := ManagementInterface(devParams, 0, 0)
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("ManagementInterface(%v,0,0) got error %v, want substring %q", devParams, err, wantErr)
+		}
+	})
+}
+
+func TestPowerSupplyAndFans(t *testing.T) {
+	setFakeNamer(&fakeNamer{
+		PowerSupplyFn: func(index uint) (string, error) {
+			return fmt.Sprintf("fakePSU%d", index), nil
+		},
+		FanTrayFn: func(index uint) (string, error) {
+			return fmt.Sprintf("fakeFanTray%d", index), nil
+		},
+		FanFn: func(trayIndex, fanIndex uint) (string, error) {
+			return fmt.Sprintf("fakeFan%d/%d", trayIndex, fanIndex), nil
+		},
+	})
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr string
+	}{{
+		desc:   "power supply",
+		nameFn: func() (string, error) { return PowerSupply(devParams, 1) },
+		want:   "fakePSU1",
+	}, {
+		desc:   "fan tray",
+		nameFn: func() (string, error) { return FanTray(devParams, 2) },
+		want:   "fakeFanTray2",
+	}, {
+		desc:   "fan",
+		nameFn: func() (string, error) { return Fan(devParams, 2, 3) },
+		want:   "fakeFan2/3",
+	}, {
+		desc:    "negative power supply",
+		nameFn:  func() (string, error) { return PowerSupply(devParams, -1) },
+		wantErr: "negative",
+	}, {
+		desc:    "negative fan tray",
+		nameFn:  func() (string, error) { return FanTray(devParams, -1) },
+		wantErr: "negative",
+	}, {
+		desc:    "negative fan",
+		nameFn:  func() (string, error) { return Fan(devParams, 0, -1) },
+		wantErr: "negative",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn()
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("got error %v, want substring %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got error %v", err)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestIntegratedCircuitAndCPU(t *testing.T) {
+	setFakeNamer(&fakeNamer{
+		IntegratedCircuitFn: func(linecardIndex, chipIndex uint) (string, error) {
+			return fmt.Sprintf("fakeChip%d/%d", linecardIndex, chipIndex), nil
+		},
+		CPUFn: func(cardKind namer.EntityKind, cardIndex, cpuIndex uint) (string, error) {
+			return fmt.Sprintf("fake %s CPU%d/%d", cardKind, cardIndex, cpuIndex), nil
+		},
+	})
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr string
+	}{{
+		desc:   "integrated circuit",
+		nameFn: func() (string, error) { return IntegratedCircuit(devParams, 1, 2) },
+		want:   "fakeChip1/2",
+	}, {
+		desc:   "linecard CPU",
+		nameFn: func() (string, error) { return CPU(devParams, KindLinecard, 1, 0) },
+		want:   "fake linecard CPU1/0",
+	}, {
+		desc:   "controller card CPU",
+		nameFn: func() (string, error) { return CPU(devParams, KindControllerCard, 0, 1) },
+		want:   "fake controller card CPU0/1",
+	}, {
+		desc:    "negative linecard",
+		nameFn:  func() (string, error) { return IntegratedCircuit(devParams, -1, 0) },
+		wantErr: "linecard index cannot be negative",
+	}, {
+		desc:    "negative chip",
+		nameFn:  func() (string, error) { return IntegratedCircuit(devParams, 0, -1) },
+		wantErr: "integrated circuit index cannot be negative",
+	}, {
+		desc:    "negative card",
+		nameFn:  func() (string, error) { return CPU(devParams, KindControllerCard, -1, 0) },
+		wantErr: "controller card index cannot be negative",
+	}, {
+		desc:    "negative CPU",
+		nameFn:  func() (string, error) { return CPU(devParams, KindLinecard, 0, -1) },
+		wantErr: "CPU index cannot be negative",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn()
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("got error %v, want substring %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got error %v", err)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
 		})
 	}
 }
 
+func TestChassisAndBackplane(t *testing.T) {
+	setFakeNamer(&fakeNamer{
+		ChassisFn: func(index uint) (string, error) {
+			return fmt.Sprintf("fakeChassis%d", index), nil
+		},
+		BackplaneFn: func(chassisIndex uint) (string, error) {
+			return fmt.Sprintf("fakeBackplane%d", chassisIndex), nil
+		},
+	})
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr string
+	}{{
+		desc:   "chassis",
+		nameFn: func() (string, error) { return Chassis(devParams, 1) },
+		want:   "fakeChassis1",
+	}, {
+		desc:   "backplane",
+		nameFn: func() (string, error) { return Backplane(devParams, 2) },
+		want:   "fakeBackplane2",
+	}, {
+		desc:    "negative chassis",
+		nameFn:  func() (string, error) { return Chassis(devParams, -1) },
+		wantErr: "negative",
+	}, {
+		desc:    "negative backplane",
+		nameFn:  func() (string, error) { return Backplane(devParams, -1) },
+		wantErr: "negative",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn()
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("got error %v, want substring %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("got error %v", err)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		setFakeNamer(&fakeNamer{
+			CapabilitiesFn: func() (*namer.Capabilities, error) {
+				return &namer.Capabilities{
+					MaxLoopbacks:       1,
+					MaxAggregates:      2,
+					MaxLinecards:       3,
+					MaxControllerCards: 4,
+					MaxFabrics:         5,
+					PortSpeeds:         []oc.E_IfEthernet_ETHERNET_SPEED{oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+				}, nil
+			},
+			IsFixedFormFactorFn: func() bool {
+				return true
+			},
+		})
+		got, err := Capabilities(devParams)
+		if err != nil {
+			t.Fatalf("Capabilities(%v) got error %v", devParams, err)
+		}
+		want := &DeviceCapabilities{
+			MaxLoopbacks:       1,
+			MaxAggregates:      2,
+			MaxLinecards:       3,
+			MaxControllerCards: 4,
+			MaxFabrics:         5,
+			PortSpeeds:         []oc.E_IfEthernet_ETHERNET_SPEED{oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+			FixedFormFactor:    true,
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Capabilities(%v) got unexpected diff (-want +got):\n%s", devParams, diff)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		const wantErr = "CapabilitiesErr"
+		setFakeNamer(&fakeNamer{CapabilitiesFn: func() (*namer.Capabilities, error) {
+			return nil, errors.New(wantErr)
+		}})
+		_, err := Capabilities(devParams)
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Errorf("Capabilities(%v) got error %v, want substring %q", devParams, err, wantErr)
+		}
+	})
+}
+
+func TestAllLinecards(t *testing.T) {
+	// Even indices are rejected, so the odd indices are the valid ones.
+	linecardFn := func(index uint) (string, error) {
+		if index%2 == 0 {
+			return "", fmt.Errorf("invalid linecard %d", index)
+		}
+		return fmt.Sprintf("fakeLinecard%d", index), nil
+	}
+	capsFn := func(maxLinecards uint) func() (*namer.Capabilities, error) {
+		return func() (*namer.Capabilities, error) {
+			return &namer.Capabilities{MaxLinecards: maxLinecards}, nil
+		}
+	}
+	type indexName struct {
+		Index int
+		Name  string
+	}
+	tests := []struct {
+		desc  string
+		fake  *fakeNamer
+		limit int
+		want  []indexName
+	}{{
+		desc: "skips rejected indices",
+		fake: &fakeNamer{LinecardFn: linecardFn, CapabilitiesFn: capsFn(3)},
+		want: []indexName{{1, "fakeLinecard1"}, {3, "fakeLinecard3"}, {5, "fakeLinecard5"}},
+	}, {
+		desc:  "early break",
+		fake:  &fakeNamer{LinecardFn: linecardFn, CapabilitiesFn: capsFn(3)},
+		limit: 2,
+		want:  []indexName{{1, "fakeLinecard1"}, {3, "fakeLinecard3"}},
+	}, {
+		desc: "no linecards",
+		fake: &fakeNamer{LinecardFn: linecardFn, CapabilitiesFn: capsFn(0)},
+	}, {
+		desc: "fewer valid indices than max",
+		fake: &fakeNamer{
+			LinecardFn: func(index uint) (string, error) {
+				if index > 1 {
+					return "", fmt.Errorf("invalid linecard %d", index)
+				}
+				return fmt.Sprintf("fakeLinecard%d", index), nil
+			},
+			CapabilitiesFn: capsFn(1000),
+		},
+		want: []indexName{{0, "fakeLinecard0"}, {1, "fakeLinecard1"}},
+	}, {
+		desc: "capabilities error",
+		fake: &fakeNamer{
+			LinecardFn: linecardFn,
+			CapabilitiesFn: func() (*namer.Capabilities, error) {
+				return nil, errors.New("CapabilitiesErr")
+			},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			setFakeNamer(test.fake)
+			var got []indexName
+			for index, name := range AllLinecards(devParams) {
+				got = append(got, indexName{index, name})
+				if len(got) == test.limit {
+					break
+				}
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("AllLinecards(%v) got unexpected diff (-want +got):\n%s", devParams, diff)
+			}
+		})
+	}
+
+	t.Run("ciena WR7", func(t *testing.T) {
+		dp := &DeviceParams{Vendor: VendorCiena, HardwareModel: "WR7"}
+		var got []indexName
+		for index, name := range AllLinecards(dp) {
+			got = append(got, indexName{index, name})
+		}
+		want := []indexName{{4, "ib-1/4"}, {5, "ib-1/5"}, {6, "ib-1/6"}, {7, "ib-1/7"}}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("AllLinecards(%v) got unexpected diff (-want +got):\n%s", dp, diff)
+		}
+	})
+
+	t.Run("fixed form factor", func(t *testing.T) {
+		for _, dp := range []*DeviceParams{
+			{Vendor: VendorArista, HardwareModel: "DCS-7280CR3-32P4"},
+			{Vendor: VendorCisco, HardwareModel: "8201-32FH"},
+			{Vendor: VendorNokia, HardwareModel: "7220 IXR-D3L"},
+		} {
+			for index, name := range AllLinecards(dp) {
+				t.Errorf("AllLinecards(%v) got (%d, %q), want no names", dp, index, name)
+			}
+			for index, name := range AllFabrics(dp) {
+				t.Errorf("AllFabrics(%v) got (%d, %q), want no names", dp, index, name)
+			}
+		}
+	})
+
+	t.Run("unsupported vendor", func(t *testing.T) {
+		dp := &DeviceParams{Vendor: Vendor("unknown")}
+		for index, name := range AllLinecards(dp) {
+			t.Errorf("AllLinecards(%v) got (%d, %q), want no names", dp, index, name)
+		}
+	})
+}
+
+func TestAllControllerCardsAndFabrics(t *testing.T) {
+	setFakeNamer(&fakeNamer{
+		ControllerCardFn: func(index uint) (string, error) {
+			return fmt.Sprintf("fakeControllerCard%d", index), nil
+		},
+		FabricFn: func(index uint) (string, error) {
+			return fmt.Sprintf("fakeFabric%d", index), nil
+		},
+		CapabilitiesFn: func() (*namer.Capabilities, error) {
+			return &namer.Capabilities{MaxControllerCards: 2, MaxFabrics: 3}, nil
+		},
+	})
+	var gotCCs, gotFabrics []string
+	for _, name := range AllControllerCards(devParams) {
+		gotCCs = append(gotCCs, name)
+	}
+	for _, name := range AllFabrics(devParams) {
+		gotFabrics = append(gotFabrics, name)
+	}
+	if diff := cmp.Diff([]string{"fakeControllerCard0", "fakeControllerCard1"}, gotCCs); diff != "" {
+		t.Errorf("AllControllerCards(%v) got unexpected diff (-want +got):\n%s", devParams, diff)
+	}
+	if diff := cmp.Diff([]string{"fakeFabric0", "fakeFabric1", "fakeFabric2"}, gotFabrics); diff != "" {
+		t.Errorf("AllFabrics(%v) got unexpected diff (-want +got):\n%s", devParams, diff)
+	}
+}
+
 func TestCommonQoSQueues(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		var want = &namer.CommonQoSQueueNames{
@@ -411,18 +1537,148 @@ func TestCommonQoSQueues(t *testing.T) {
 	})
 }
 
+func TestRegisterVendor(t *testing.T) {
+	const registeredVendor = Vendor("registered")
+	const want = "registeredFabric0"
+	fn := &fakeNamer{FabricFn: func(uint) (string, error) {
+		return want, nil
+	}}
+	if err := RegisterVendor(registeredVendor, func(string) namer.Namer { return fn }); err != nil {
+		t.Fatalf("RegisterVendor(%v) got error %v", registeredVendor, err)
+	}
+	dp := &DeviceParams{Vendor: registeredVendor}
+	got, err := Fabric(dp, 0)
+	if err != nil {
+		t.Errorf("Fabric(%v,0) got error %v", dp, err)
+	}
+	if got != want {
+		t.Errorf("Fabric(%v,0) got %q, want %q", dp, got, want)
+	}
+
+	t.Run("core only", func(t *testing.T) {
+		const coreVendor = Vendor("coreOnly")
+		if err := RegisterVendor(coreVendor, func(string) namer.Namer { return coreNamer{fn} }); err != nil {
+			t.Fatalf("RegisterVendor(%v) got error %v", coreVendor, err)
+		}
+		dp := &DeviceParams{Vendor: coreVendor, HardwareModel: "any"}
+		if got, err := Fabric(dp, 0); err != nil || got != want {
+			t.Errorf("Fabric(%v,0) got (%q, %v), want %q", dp, got, err, want)
+		}
+		extensionTests := []struct {
+			desc string
+			fn   func() error
+		}{{
+			desc: "PowerSupply",
+			fn:   func() error { _, err := PowerSupply(dp, 0); return err },
+		}, {
+			desc: "ParsePort",
+			fn:   func() error { _, err := ParsePort(dp, "port0"); return err },
+		}, {
+			desc: "Capabilities",
+			fn:   func() error { _, err := Capabilities(dp); return err },
+		}}
+		for _, test := range extensionTests {
+			if err := test.fn(); !errors.Is(err, ErrUnsupportedEntity) {
+				t.Errorf("%s(%v) got error %v, want %v", test.desc, dp, err, ErrUnsupportedEntity)
+			}
+		}
+		for i, name := range AllPowerSupplies(dp) {
+			t.Errorf("AllPowerSupplies(%v) got (%d, %q), want none", dp, i, name)
+		}
+	})
+
+	badTests := []struct {
+		desc    string
+		vendor  Vendor
+		factory func(string) namer.Namer
+		wantErr string
+	}{{
+		desc:    "duplicate",
+		vendor:  registeredVendor,
+		factory: func(string) namer.Namer { return fn },
+		wantErr: "already registered",
+	}, {
+		desc:    "built-in",
+		vendor:  VendorArista,
+		factory: func(string) namer.Namer { return fn },
+		wantErr: "already registered",
+	}, {
+		desc:    "empty vendor",
+		factory: func(string) namer.Namer { return fn },
+		wantErr: "empty",
+	}, {
+		desc:    "nil factory",
+		vendor:  Vendor("nilFactory"),
+		wantErr: "nil",
+	}}
+	for _, test := range badTests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := RegisterVendor(test.vendor, test.factory)
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("RegisterVendor(%v) got error %v, want substring %q", test.vendor, err, test.wantErr)
+			}
+		})
+	}
+
+	t.Run("concurrent", func(t *testing.T) {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				v := Vendor(fmt.Sprintf("concurrent%d", i))
+				if err := RegisterVendor(v, func(string) namer.Namer { return fn }); err != nil {
+					t.Errorf("RegisterVendor(%v) got error %v", v, err)
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				if _, err := Fabric(dp, 0); err != nil {
+					t.Errorf("Fabric(%v,0) got error %v", dp, err)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+}
+
 func setFakeNamer(fn *fakeNamer) {
+	namerFactoriesMu.Lock()
+	defer namerFactoriesMu.Unlock()
 	namerFactories[fakeVendor] = func(string) namer.Namer { return fn }
 }
 
+// coreNamer implements only the core Namer interface, like a Namer registered
+// by a user of an earlier version of the library.
+type coreNamer struct {
+	namer.Namer
+}
+
 var _ namer.Namer = (*fakeNamer)(nil)
 
 type fakeNamer struct {
 	LoopbackInterfaceFn, AggregateInterfaceFn, AggregateMemberInterfaceFn,
-	LinecardFn, ControllerCardFn, FabricFn func(uint) (string, error)
-	PortFn              func(*namer.PortParams) (string, error)
-	IsFixedFormFactorFn func() bool
-	CommonQoSQueuesFn   func(*namer.QoSParams) (*namer.CommonQoSQueueNames, error)
+	LinecardFn, ControllerCardFn, FabricFn, PowerSupplyFn, FanTrayFn,
+	ChassisFn, BackplaneFn func(uint) (string, error)
+	FanFn                 func(uint, uint) (string, error)
+	IntegratedCircuitFn   func(uint, uint) (string, error)
+	CPUFn                 func(namer.EntityKind, uint, uint) (string, error)
+	SubinterfaceFn        func(string, uint) (string, error)
+	VLANInterfaceFn       func(uint) (string, error)
+	TunnelInterfaceFn     func(*namer.TunnelParams) (string, error)
+	ManagementInterfaceFn func(uint, uint) (string, error)
+	PortFn                func(*namer.PortParams) (string, error)
+	ParsePortFn           func(string) (*namer.PortParams, error)
+	TransceiverFn         func(*namer.PortParams) (string, error)
+	PortASICFn            func(*namer.PortParams) (string, error)
+	OpticalChannelFn      func(*namer.PortParams, uint) (string, error)
+	LogicalChannelIndexFn func(*namer.PortParams, namer.LogicalChannelKind) (uint32, error)
+	ParseIndexFn          func(namer.EntityKind, string) (uint, error)
+	IsFixedFormFactorFn   func() bool
+	// ValidateHardwareModelFn may be nil, in which case all models are valid.
+	ValidateHardwareModelFn func() error
+	CapabilitiesFn          func() (*namer.Capabilities, error)
+	CommonQoSQueuesFn       func(*namer.QoSParams) (*namer.CommonQoSQueueNames, error)
 }
 
 func (fn *fakeNamer) LoopbackInterface(index uint) (string, error) {
@@ -437,6 +1693,66 @@ func (fn *fakeNamer) AggregateMemberInterface(index uint) (string, error) {
 	return fn.AggregateMemberInterfaceFn(index)
 }
 
+func (fn *fakeNamer) Subinterface(parent string, index uint) (string, error) {
+	return fn.SubinterfaceFn(parent, index)
+}
+
+func (fn *fakeNamer) VLANInterface(vlanID uint) (string, error) {
+	return fn.VLANInterfaceFn(vlanID)
+}
+
+func (fn *fakeNamer) TunnelInterface(tp *namer.TunnelParams) (string, error) {
+	return fn.TunnelInterfaceFn(tp)
+}
+
+func (fn *fakeNamer) ManagementInterface(controllerCardIndex, index uint) (string, error) {
+	return fn.ManagementInterfaceFn(controllerCardIndex, index)
+}
+
+func (fn *fakeNamer) Transceiver(pp *namer.PortParams) (string, error) {
+	return fn.TransceiverFn(pp)
+}
+
+func (fn *fakeNamer) OpticalChannel(pp *namer.PortParams, index uint) (string, error) {
+	return fn.OpticalChannelFn(pp, index)
+}
+
+func (fn *fakeNamer) LogicalChannelIndex(pp *namer.PortParams, kind namer.LogicalChannelKind) (uint32, error) {
+	return fn.LogicalChannelIndexFn(pp, kind)
+}
+
+func (fn *fakeNamer) PortASIC(pp *namer.PortParams) (string, error) {
+	return fn.PortASICFn(pp)
+}
+
+func (fn *fakeNamer) PowerSupply(index uint) (string, error) {
+	return fn.PowerSupplyFn(index)
+}
+
+func (fn *fakeNamer) FanTray(index uint) (string, error) {
+	return fn.FanTrayFn(index)
+}
+
+func (fn *fakeNamer) Fan(trayIndex, fanIndex uint) (string, error) {
+	return fn.FanFn(trayIndex, fanIndex)
+}
+
+func (fn *fakeNamer) IntegratedCircuit(linecardIndex, chipIndex uint) (string, error) {
+	return fn.IntegratedCircuitFn(linecardIndex, chipIndex)
+}
+
+func (fn *fakeNamer) CPU(cardKind namer.EntityKind, cardIndex, cpuIndex uint) (string, error) {
+	return fn.CPUFn(cardKind, cardIndex, cpuIndex)
+}
+
+func (fn *fakeNamer) Chassis(index uint) (string, error) {
+	return fn.ChassisFn(index)
+}
+
+func (fn *fakeNamer) Backplane(chassisIndex uint) (string, error) {
+	return fn.BackplaneFn(chassisIndex)
+}
+
 func (fn *fakeNamer) Linecard(index uint) (string, error) {
 	return fn.LinecardFn(index)
 }
@@ -453,10 +1769,29 @@ func (fn *fakeNamer) Port(pp *namer.PortParams) (string, error) {
 	return fn.PortFn(pp)
 }
 
+func (fn *fakeNamer) ParsePort(name string) (*namer.PortParams, error) {
+	return fn.ParsePortFn(name)
+}
+
+func (fn *fakeNamer) ParseIndex(kind namer.EntityKind, name string) (uint, error) {
+	return fn.ParseIndexFn(kind, name)
+}
+
+func (fn *fakeNamer) ValidateHardwareModel() error {
+	if fn.ValidateHardwareModelFn == nil {
+		return nil
+	}
+	return fn.ValidateHardwareModelFn()
+}
+
 func (fn *fakeNamer) IsFixedFormFactor() bool {
 	return fn.IsFixedFormFactorFn()
 }
 
+func (fn *fakeNamer) Capabilities() (*namer.Capabilities, error) {
+	return fn.CapabilitiesFn()
+}
+
 func (fn *fakeNamer) CommonQoSQueues(qp *namer.QoSParams) (*namer.CommonQoSQueueNames, error) {
 	return fn.CommonQoSQueuesFn(qp)
 }
@@ -5,13 +5,13 @@ go 1.24.0
 toolchain go1.25.5
 
 require (
+	github.com/google/go-cmp v0.7.0
 	github.com/openconfig/goyang v1.6.3
 	github.com/openconfig/ygot v0.34.0
 )
 
 require (
 	github.com/golang/glog v1.2.5 // indirect
-	github.com/google/go-cmp v0.7.0 // indirect
 	github.com/kylelemons/godebug v1.1.0 // indirect
 	github.com/openconfig/gnmi v0.14.1 // indirect
 	golang.org/x/exp v0.0.0-20250218142911-aa4b98e5adaa // indirect
@@ -17,34 +17,117 @@ package arista
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
-	"github.com/openconfig/entity-naming/internal/namer"
+	"github.com/openconfig/entity-naming/internal/namerutil"
+	"github.com/openconfig/entity-naming/namer"
+	"github.com/openconfig/entity-naming/oc"
 )
 
-var _ namer.Namer = (*Namer)(nil)
+var _ namerutil.Namer = (*Namer)(nil)
+
+const (
+	maxLoopbackIndex          = 1000
+	maxAggregateIndex         = 999998
+	maxChassisIndex           = 0
+	maxIntegratedCircuitIndex = 5
+	maxCPUIndex               = 0
+	maxSubinterfaceIndex      = 4094
+	minVLANID                 = 1
+	maxVLANID                 = 4094
+	maxTunnelIndex            = 255
+	maxManagementIndex        = 1
+)
+
+// chassisName is the name of the only chassis.
+const chassisName = "Chassis"
+
+var portSpeeds = []oc.E_IfEthernet_ETHERNET_SPEED{
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_50GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_200GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_800GB,
+}
+
+// hardwareModel describes a family of Arista hardware models.
+type hardwareModel struct {
+	namerutil.HardwareModel
+	// lanesPerPort is the number of electrical lanes of each port: 8 for
+	// QSFP-DD and OSFP ports, and 4 for QSFP28 ports.
+	lanesPerPort uint
+	// octalLaneSpeeds are the port speeds served only by the 8-lane QSFP-DD
+	// or OSFP ports of hardware models whose other ports are QSFP28.
+	octalLaneSpeeds []oc.E_IfEthernet_ETHERNET_SPEED
+}
+
+// lanes returns the number of electrical lanes of a port of the speed.
+func (hwm *hardwareModel) lanes(speed oc.E_IfEthernet_ETHERNET_SPEED) uint {
+	if slices.Contains(hwm.octalLaneSpeeds, speed) {
+		return 8
+	}
+	return hwm.lanesPerPort
+}
+
+// skuPrefixes are the prefixes of the Arista SKUs that inventories report,
+// such as DCS-7280CR3-32P4, that are not part of the hardware model name.
+var skuPrefixes = []string{"DCS-", "CCS-"}
+
+// hardwareModels are the known Arista hardware model families: the 7060X and
+// 7280R fixed switches, and the 7800R modular chassis, which inventories
+// report by their number of slots. The 7280R3 line has 400G-only models with
+// QSFP-DD or OSFP ports, models that add four such 400G ports to 32 QSFP28
+// ports, and QSFP28-only models. More specific prefixes must precede the
+// prefixes they extend.
+var hardwareModels = []hardwareModel{
+	{HardwareModel: namerutil.HardwareModel{Prefixes: []string{"7060DX", "7060PX"}, FixedFormFactor: true, NumLinecards: 0, NumControllerCards: 1, NumFabrics: 0, NumPowerSupplies: 2, NumFanTrays: 4, NumFansPerTray: 1, PortsPerASIC: 64}, lanesPerPort: 8},
+	{HardwareModel: namerutil.HardwareModel{Prefixes: []string{"7060"}, FixedFormFactor: true, NumLinecards: 0, NumControllerCards: 1, NumFabrics: 0, NumPowerSupplies: 2, NumFanTrays: 4, NumFansPerTray: 1, PortsPerASIC: 64}, lanesPerPort: 4},
+	{HardwareModel: namerutil.HardwareModel{Prefixes: []string{"7280DR3", "7280PR3"}, FixedFormFactor: true, NumLinecards: 0, NumControllerCards: 1, NumFabrics: 0, NumPowerSupplies: 2, NumFanTrays: 6, NumFansPerTray: 1, PortsPerASIC: 12}, lanesPerPort: 8},
+	{HardwareModel: namerutil.HardwareModel{Prefixes: []string{"7280CR3-32P4", "7280CR3-32D4", "7280CR3K-32P4", "7280CR3K-32D4"}, FixedFormFactor: true, NumLinecards: 0, NumControllerCards: 1, NumFabrics: 0, NumPowerSupplies: 2, NumFanTrays: 6, NumFansPerTray: 1, PortsPerASIC: 24}, lanesPerPort: 4, octalLaneSpeeds: []oc.E_IfEthernet_ETHERNET_SPEED{oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB}},
+	{HardwareModel: namerutil.HardwareModel{Prefixes: []string{"7280"}, FixedFormFactor: true, NumLinecards: 0, NumControllerCards: 1, NumFabrics: 0, NumPowerSupplies: 2, NumFanTrays: 6, NumFansPerTray: 1, PortsPerASIC: 24}, lanesPerPort: 4},
+	{HardwareModel: namerutil.HardwareModel{Prefixes: []string{"7800", "7804", "7808", "7812", "7816"}, NumLinecards: 8, NumControllerCards: 2, NumFabrics: 6, NumPowerSupplies: 12, NumFanTrays: 12, NumFansPerTray: 3, PortsPerASIC: 6}, lanesPerPort: 8},
+}
+
+// defaultHardwareModel describes the device if its hardware model is not
+// given: a 7800R chassis, the last family, with as many power supplies and
+// fans as the 7816.
+var defaultHardwareModel = hardwareModels[len(hardwareModels)-1]
 
 // Namer is an Arista implementation of the Namer interface.
 type Namer struct {
 	HardwareModel string
 }
 
+// family returns the Arista family of the hardware model of the device, or
+// the default family if the hardware model is not listed, so that names that
+// do not depend on the hardware model are available for every device.
+// The DCS- and CCS- prefixes of Arista SKUs are ignored, as are case and the
+// choice of separators.
+func (n *Namer) family() *hardwareModel {
+	hwm, _ := namerutil.FindHardwareModel(hardwareModels, &defaultHardwareModel, n.HardwareModel, skuPrefixes...)
+	return hwm
+}
+
 // LoopbackInterface is an implementation of namer.LoopbackInterface.
 func (n *Namer) LoopbackInterface(index uint) (string, error) {
-	const maxIndex = 1000
-	if index > maxIndex {
+	if index > maxLoopbackIndex {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Arista loopback index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindLoopback, Index: int(index), Max: maxLoopbackIndex})
 	}
 	return fmt.Sprintf("Loopback%d", index), nil
 }
 
 // AggregateInterface is an implementation of namer.AggregateInterface.
 func (n *Namer) AggregateInterface(index uint) (string, error) {
-	const maxIndex = 999998
-	if index > maxIndex {
+	if index > maxAggregateIndex {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Arista aggregate index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindAggregate, Index: int(index), Max: maxAggregateIndex})
 	}
 	return fmt.Sprintf("Port-Channel%d", index+1), nil
 }
@@ -54,36 +137,178 @@ func (n *Namer) AggregateMemberInterface(index uint) (string, error) {
 	return n.AggregateInterface(index)
 }
 
+// Subinterface is an implementation of namer.Subinterface.
+func (n *Namer) Subinterface(parent string, index uint) (string, error) {
+	if index > maxSubinterfaceIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindSubinterface, Index: int(index), Max: maxSubinterfaceIndex})
+	}
+	return fmt.Sprintf("%s.%d", parent, index), nil
+}
+
+// VLANInterface is an implementation of namer.VLANInterface.
+func (n *Namer) VLANInterface(vlanID uint) (string, error) {
+	if vlanID < minVLANID || vlanID > maxVLANID {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindVLANInterface, Index: int(vlanID), Max: maxVLANID})
+	}
+	return fmt.Sprintf("Vlan%d", vlanID), nil
+}
+
+// TunnelInterface is an implementation of namer.TunnelInterface.
+// Arista names GRE and IP-in-IP tunnels alike, as the tunnel mode is configured
+// on the interface.
+func (n *Namer) TunnelInterface(tp *namer.TunnelParams) (string, error) {
+	if tp.Kind != namer.TunnelGRE && tp.Kind != namer.TunnelIPinIP {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista does not support %s tunnel interfaces: %w", tp.Kind, namer.ErrUnsupportedEntity)
+	}
+	if tp.Index > maxTunnelIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindTunnelInterface, Index: int(tp.Index), Max: maxTunnelIndex})
+	}
+	return fmt.Sprintf("Tunnel%d", tp.Index), nil
+}
+
+// ManagementInterface is an implementation of namer.ManagementInterface.
+func (n *Namer) ManagementInterface(controllerCardIndex, index uint) (string, error) {
+	if _, err := n.ControllerCard(controllerCardIndex); err != nil {
+		return "", err
+	}
+	if index > maxManagementIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindManagementInterface, Index: int(index), Max: maxManagementIndex})
+	}
+	if n.IsFixedFormFactor() {
+		return fmt.Sprintf("Management%d", index+1), nil
+	}
+	return fmt.Sprintf("Management%d/%d", controllerCardIndex+1, index+1), nil
+}
+
 // Linecard is an implementation of namer.Linecard.
 func (n *Namer) Linecard(index uint) (string, error) {
-	const maxIndex = 7
-	if index > maxIndex {
+	hwm := n.family()
+	if hwm.NumLinecards == 0 {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista fixed form factor switches have no linecards: %w", namer.ErrUnsupportedEntity)
+	}
+	if index >= hwm.NumLinecards {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Arista linecard index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindLinecard, Index: int(index), Max: int(hwm.NumLinecards) - 1})
 	}
 	return fmt.Sprintf("Linecard%d", index+3), nil
 }
 
 // ControllerCard is an implementation of namer.ControllerCard.
 func (n *Namer) ControllerCard(index uint) (string, error) {
-	const maxIndex = 1
-	if index > maxIndex {
+	hwm := n.family()
+	if index >= hwm.NumControllerCards {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Arista controller card index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindControllerCard, Index: int(index), Max: int(hwm.NumControllerCards) - 1})
 	}
 	return fmt.Sprintf("Supervisor%d", index+1), nil
 }
 
 // Fabric is an implementation of namer.Fabric.
 func (n *Namer) Fabric(index uint) (string, error) {
-	const maxIndex = 5
-	if index > maxIndex {
+	hwm := n.family()
+	if hwm.NumFabrics == 0 {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Arista fabric index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Arista fixed form factor switches have no fabrics: %w", namer.ErrUnsupportedEntity)
+	}
+	if index >= hwm.NumFabrics {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindFabric, Index: int(index), Max: int(hwm.NumFabrics) - 1})
 	}
 	return fmt.Sprintf("Fabric%d", index+1), nil
 }
 
+// PowerSupply is an implementation of namer.PowerSupply.
+func (n *Namer) PowerSupply(index uint) (string, error) {
+	hwm := n.family()
+	if index >= hwm.NumPowerSupplies {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindPowerSupply, Index: int(index), Max: int(hwm.NumPowerSupplies) - 1})
+	}
+	return fmt.Sprintf("PowerSupply%d", index+1), nil
+}
+
+// FanTray is an implementation of namer.FanTray.
+func (n *Namer) FanTray(index uint) (string, error) {
+	hwm := n.family()
+	if index >= hwm.NumFanTrays {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindFanTray, Index: int(index), Max: int(hwm.NumFanTrays) - 1})
+	}
+	return fmt.Sprintf("FanTray%d", index+1), nil
+}
+
+// Fan is an implementation of namer.Fan.
+func (n *Namer) Fan(trayIndex, fanIndex uint) (string, error) {
+	if _, err := n.FanTray(trayIndex); err != nil {
+		return "", err
+	}
+	hwm := n.family()
+	if fanIndex >= hwm.NumFansPerTray {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindFan, Index: int(fanIndex), Max: int(hwm.NumFansPerTray) - 1})
+	}
+	return fmt.Sprintf("Fan%d/%d", trayIndex+1, fanIndex+1), nil
+}
+
+// IntegratedCircuit is an implementation of namer.IntegratedCircuit.
+// The chips of a fixed form factor switch are on its chassis rather than on
+// a linecard, so they are named "Chip1", "Chip2", and so on, and linecard
+// index zero stands for the chassis.
+func (n *Namer) IntegratedCircuit(linecardIndex, chipIndex uint) (string, error) {
+	var prefix string
+	if n.IsFixedFormFactor() {
+		if linecardIndex > 0 {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindLinecard, Index: int(linecardIndex), Max: 0})
+		}
+	} else {
+		lc, err := n.Linecard(linecardIndex)
+		if err != nil {
+			return "", err
+		}
+		prefix = lc + "/"
+	}
+	if chipIndex > maxIntegratedCircuitIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindIntegratedCircuit, Index: int(chipIndex), Max: maxIntegratedCircuitIndex})
+	}
+	return fmt.Sprintf("%sChip%d", prefix, chipIndex+1), nil
+}
+
+// CPU is an implementation of namer.CPU.
+func (n *Namer) CPU(cardKind namer.EntityKind, cardIndex, cpuIndex uint) (string, error) {
+	card, err := namerutil.Card(n, cardKind, cardIndex)
+	if err != nil {
+		return "", err
+	}
+	if cpuIndex > maxCPUIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindCPU, Index: int(cpuIndex), Max: maxCPUIndex})
+	}
+	return fmt.Sprintf("%s/CPU%d", card, cpuIndex), nil
+}
+
+// Chassis is an implementation of namer.Chassis.
+func (n *Namer) Chassis(index uint) (string, error) {
+	if index > maxChassisIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Arista %w", &namer.IndexOutOfRangeError{Entity: namer.KindChassis, Index: int(index), Max: maxChassisIndex})
+	}
+	return chassisName, nil
+}
+
+// Backplane is an implementation of namer.Backplane.
+func (n *Namer) Backplane(uint) (string, error) {
+	//nolint:staticcheck // ST1005 string begins with proper noun
+	return "", fmt.Errorf("Arista backplanes are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
 // Port is an implementation of namer.Port.
 func (n *Namer) Port(pp *namer.PortParams) (string, error) {
 	var nameBuilder strings.Builder
@@ -93,19 +318,179 @@ func (n *Namer) Port(pp *namer.PortParams) (string, error) {
 	}
 	nameBuilder.WriteString(fmt.Sprintf("%d", pp.PortIndex))
 	if pp.Channelizable {
-		if pp.ChannelIndex == nil {
-			nameBuilder.WriteString("/1")
-		} else {
-			nameBuilder.WriteString(fmt.Sprintf("/%d", *pp.ChannelIndex))
+		lane, err := n.firstLane(pp)
+		if err != nil {
+			return "", err
 		}
+		nameBuilder.WriteString(fmt.Sprintf("/%d", lane))
 	}
 	return nameBuilder.String(), nil
 }
 
+// firstLane returns the one-based number of the first lane of the channel of
+// a channelizable port, by which Arista numbers the channel. An unchannelized
+// port starts at the first lane. If the breakout mode is not known, each
+// channel is assumed to have a single lane.
+func (n *Namer) firstLane(pp *namer.PortParams) (uint, error) {
+	if pp.ChannelIndex == nil {
+		return 1, nil
+	}
+	lanes := n.family().lanes(pp.Speed)
+	lanesPerChannel := uint(1)
+	if pp.Breakout != nil && pp.Breakout.NumChannels > 0 {
+		if numChannels := pp.Breakout.NumChannels; numChannels > lanes || lanes%numChannels != 0 {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return 0, fmt.Errorf("Arista cannot break out a %d-lane port into %d channels: %w", lanes, numChannels, namer.ErrUnsupportedEntity)
+		}
+		lanesPerChannel = lanes / pp.Breakout.NumChannels
+	}
+	lane := *pp.ChannelIndex*lanesPerChannel + 1
+	if lane > lanes {
+		// The channel would start beyond the last lane of the port.
+		return 0, &namer.IndexOutOfRangeError{Entity: namer.KindChannel, Index: int(*pp.ChannelIndex), Max: int((lanes - 1) / lanesPerChannel)}
+	}
+	return lane, nil
+}
+
+var (
+	modularPortRE = regexp.MustCompile(`^Ethernet(\d+)/(\d+)(?:/(\d+))?$`)
+	fixedPortRE   = regexp.MustCompile(`^Ethernet(\d+)(?:/(\d+))?$`)
+)
+
+// ParsePort is an implementation of namer.ParsePort.
+// Arista names the first channel of a channelized port the same as the
+// unchannelized port, so a lane number of 1 is parsed as unchannelized. Names
+// encode neither the speed nor the breakout mode, so each channel is assumed
+// to have a single lane: Ethernet1/5 of a port broken out into four 2-lane
+// channels is parsed as channel 4, not channel 2.
+func (n *Namer) ParsePort(name string) (*namer.PortParams, error) {
+	fixedFormFactor := n.IsFixedFormFactor()
+	re := modularPortRE
+	if fixedFormFactor {
+		re = fixedPortRE
+	}
+	indices, ok := namerutil.MatchIndices(re, name)
+	if !ok {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return nil, fmt.Errorf("Arista port name %q is invalid", name)
+	}
+	pp := new(namer.PortParams)
+	if !fixedFormFactor {
+		if *indices[0] < 3 {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return nil, fmt.Errorf("Arista port name %q has a slot number less than 3", name)
+		}
+		slotIndex := *indices[0] - 3
+		pp.SlotIndex = &slotIndex
+		indices = indices[1:]
+	}
+	pp.PortIndex = *indices[0]
+	if lane := indices[1]; lane != nil {
+		pp.Channelizable = true
+		if *lane == 0 {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return nil, fmt.Errorf("Arista port name %q has a lane number of 0", name)
+		}
+		if *lane != 1 {
+			channelIndex := *lane - 1
+			pp.ChannelIndex = &channelIndex
+		}
+	}
+	return pp, nil
+}
+
+// Transceiver is an implementation of namer.Transceiver.
+func (n *Namer) Transceiver(pp *namer.PortParams) (string, error) {
+	if pp.SlotIndex == nil {
+		return fmt.Sprintf("Ethernet%d", pp.PortIndex), nil
+	}
+	return fmt.Sprintf("Ethernet%d/%d", *pp.SlotIndex+3, pp.PortIndex), nil
+}
+
+// OpticalChannel is an implementation of namer.OpticalChannel.
+func (n *Namer) OpticalChannel(*namer.PortParams, uint) (string, error) {
+	//nolint:staticcheck // ST1005 string begins with proper noun
+	return "", fmt.Errorf("Arista optical channels are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
+// LogicalChannelIndex is an implementation of namer.LogicalChannelIndex.
+func (n *Namer) LogicalChannelIndex(_ *namer.PortParams, kind namer.LogicalChannelKind) (uint32, error) {
+	//nolint:staticcheck // ST1005 string begins with proper noun
+	return 0, fmt.Errorf("Arista does not support %s logical channels: %w", kind, namer.ErrUnsupportedEntity)
+}
+
+// PortASIC is an implementation of namer.PortASIC.
+func (n *Namer) PortASIC(pp *namer.PortParams) (string, error) {
+	var linecardIndex uint
+	if pp.SlotIndex != nil {
+		linecardIndex = *pp.SlotIndex
+	}
+	return n.IntegratedCircuit(linecardIndex, pp.PortIndex/n.family().PortsPerASIC)
+}
+
+var (
+	loopbackRE       = regexp.MustCompile(`^Loopback(\d+)$`)
+	aggregateRE      = regexp.MustCompile(`^Port-Channel(\d+)$`)
+	linecardRE       = regexp.MustCompile(`^Linecard(\d+)$`)
+	controllerCardRE = regexp.MustCompile(`^Supervisor(\d+)$`)
+	fabricRE         = regexp.MustCompile(`^Fabric(\d+)$`)
+	vlanInterfaceRE  = regexp.MustCompile(`^Vlan(\d+)$`)
+	powerSupplyRE    = regexp.MustCompile(`^PowerSupply(\d+)$`)
+	fanTrayRE        = regexp.MustCompile(`^FanTray(\d+)$`)
+)
+
+// ParseIndex is an implementation of namer.ParseIndex.
+func (n *Namer) ParseIndex(kind namer.EntityKind, name string) (uint, error) {
+	switch kind {
+	case namer.KindLoopback:
+		return namerutil.InvertIndex(name, loopbackRE, 0, n.LoopbackInterface)
+	case namer.KindAggregate:
+		return namerutil.InvertIndex(name, aggregateRE, 1, n.AggregateInterface)
+	case namer.KindAggregateMember:
+		return namerutil.InvertIndex(name, aggregateRE, 1, n.AggregateMemberInterface)
+	case namer.KindLinecard:
+		return namerutil.InvertIndex(name, linecardRE, 3, n.Linecard)
+	case namer.KindControllerCard:
+		return namerutil.InvertIndex(name, controllerCardRE, 1, n.ControllerCard)
+	case namer.KindFabric:
+		return namerutil.InvertIndex(name, fabricRE, 1, n.Fabric)
+	case namer.KindVLANInterface:
+		return namerutil.InvertIndex(name, vlanInterfaceRE, 0, n.VLANInterface)
+	case namer.KindPowerSupply:
+		return namerutil.InvertIndex(name, powerSupplyRE, 1, n.PowerSupply)
+	case namer.KindFanTray:
+		return namerutil.InvertIndex(name, fanTrayRE, 1, n.FanTray)
+	case namer.KindChassis:
+		return namerutil.InvertSingleton(name, chassisName)
+	}
+	//nolint:staticcheck // ST1005 string begins with proper noun
+	return 0, fmt.Errorf("Arista cannot parse the index of a %s: %w", kind, namer.ErrUnsupportedEntity)
+}
+
 // IsFixedFormFactor is an implementation of namer.IsFixedFormFactor.
+// Without a hardware model, the device is assumed to be a 7800R chassis.
 func (n *Namer) IsFixedFormFactor() bool {
-	// TODO(arista): Fill in this implementation.
-	return false
+	return n.family().FixedFormFactor
+}
+
+// Capabilities is an implementation of namer.Capabilities.
+// The limits of hardware models that are not listed are not known.
+func (n *Namer) Capabilities() (*namer.Capabilities, error) {
+	hwm, err := namerutil.FindHardwareModel(hardwareModels, &defaultHardwareModel, n.HardwareModel, skuPrefixes...)
+	if err != nil {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return nil, fmt.Errorf("Arista %w", err)
+	}
+	return &namer.Capabilities{
+		MaxLoopbacks:       maxLoopbackIndex + 1,
+		MaxAggregates:      maxAggregateIndex + 1,
+		MaxLinecards:       hwm.NumLinecards,
+		MaxControllerCards: hwm.NumControllerCards,
+		MaxFabrics:         hwm.NumFabrics,
+		MaxPowerSupplies:   hwm.NumPowerSupplies,
+		MaxFanTrays:        hwm.NumFanTrays,
+		PortSpeeds:         portSpeeds,
+	}, nil
 }
 
 // CommonQoSQueues is an implementation of namer.CommonQoSQueues.
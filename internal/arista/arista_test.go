@@ -15,10 +15,15 @@
 package arista
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
-	"github.com/openconfig/entity-naming/internal/namer"
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/entity-naming/namer"
+	"github.com/openconfig/entity-naming/namer/namertest"
+	"github.com/openconfig/entity-naming/oc"
 )
 
 var an = new(Namer)
@@ -54,6 +59,10 @@ func TestLoopbackInterface(t *testing.T) {
 		if wantErr := "exceed"; err == nil || !strings.Contains(err.Error(), wantErr) {
 			t.Fatalf("LoopbackInterface(1001) got error %v, want substring %q", err, wantErr)
 		}
+		var rangeErr *namer.IndexOutOfRangeError
+		if !errors.As(err, &rangeErr) || rangeErr.Entity != namer.KindLoopback || rangeErr.Max != maxLoopbackIndex {
+			t.Errorf("LoopbackInterface(1001) got error %v, want an IndexOutOfRangeError with max %d", err, maxLoopbackIndex)
+		}
 	})
 }
 
@@ -125,6 +134,113 @@ func TestAggregateMemberInterface(t *testing.T) {
 	})
 }
 
+func TestSubinterface(t *testing.T) {
+	tests := []struct {
+		desc  string
+		index uint
+		want  string
+	}{{
+		desc:  "min",
+		index: 0,
+		want:  "Ethernet3/1/1.0",
+	}, {
+		desc:  "max",
+		index: 4094,
+		want:  "Ethernet3/1/1.4094",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := an.Subinterface("Ethernet3/1/1", test.index)
+			if err != nil {
+				t.Fatalf("Subinterface(%q,%d) got error: %v", "Ethernet3/1/1", test.index, err)
+			}
+			if got != test.want {
+				t.Errorf("Subinterface(%q,%d) got %q, want %q", "Ethernet3/1/1", test.index, got, test.want)
+			}
+		})
+	}
+
+	t.Run("over max", func(t *testing.T) {
+		_, err := an.Subinterface("Ethernet3/1/1", 4095)
+		if wantErr := "exceed"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("Subinterface(%q,4095) got error %v, want substring %q", "Ethernet3/1/1", err, wantErr)
+		}
+	})
+}
+
+func TestVLANInterface(t *testing.T) {
+	tests := []struct {
+		desc   string
+		vlanID uint
+		want   string
+	}{{
+		desc:   "min",
+		vlanID: 1,
+		want:   "Vlan1",
+	}, {
+		desc:   "max",
+		vlanID: 4094,
+		want:   "Vlan4094",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := an.VLANInterface(test.vlanID)
+			if err != nil {
+				t.Fatalf("VLANInterface(%d) got error: %v", test.vlanID, err)
+			}
+			if got != test.want {
+				t.Errorf("VLANInterface(%d) got %q, want %q", test.vlanID, got, test.want)
+			}
+		})
+	}
+
+	for _, vlanID := range []uint{0, 4095} {
+		t.Run(fmt.Sprintf("invalid %d", vlanID), func(t *testing.T) {
+			_, err := an.VLANInterface(vlanID)
+			var rangeErr *namer.IndexOutOfRangeError
+			if !errors.As(err, &rangeErr) || rangeErr.Entity != namer.KindVLANInterface {
+				t.Errorf("VLANInterface(%d) got error %v, want an IndexOutOfRangeError", vlanID, err)
+			}
+		})
+	}
+}
+
+func TestTunnelInterface(t *testing.T) {
+	tests := []struct {
+		desc    string
+		tp      *namer.TunnelParams
+		want    string
+		wantErr bool
+	}{{
+		desc: "GRE",
+		tp:   &namer.TunnelParams{Kind: namer.TunnelGRE, Index: 0},
+		want: "Tunnel0",
+	}, {
+		desc: "IP-in-IP",
+		tp:   &namer.TunnelParams{Kind: namer.TunnelIPinIP, Index: 255},
+		want: "Tunnel255",
+	}, {
+		desc:    "over max",
+		tp:      &namer.TunnelParams{Kind: namer.TunnelGRE, Index: 256},
+		wantErr: true,
+	}, {
+		desc:    "MPLS-TE",
+		tp:      &namer.TunnelParams{Kind: namer.TunnelMPLSTE, Index: 0},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := an.TunnelInterface(test.tp)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("TunnelInterface(%v) got error %v, want error %v", test.tp, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("TunnelInterface(%v) got %q, want %q", test.tp, got, test.want)
+			}
+		})
+	}
+}
+
 func TestPort(t *testing.T) {
 	uintPtr := func(i uint) *uint { return &i }
 
@@ -152,7 +268,7 @@ func TestPort(t *testing.T) {
 		pp: &namer.PortParams{
 			SlotIndex:     uintPtr(1),
 			PortIndex:     3,
-			ChannelIndex:  uintPtr(4),
+			ChannelIndex:  uintPtr(3),
 			Channelizable: true,
 		},
 		want: "Ethernet4/3/4",
@@ -173,7 +289,7 @@ func TestPort(t *testing.T) {
 		desc: "fixed form factor - channelized",
 		pp: &namer.PortParams{
 			PortIndex:     3,
-			ChannelIndex:  uintPtr(4),
+			ChannelIndex:  uintPtr(3),
 			Channelizable: true,
 		},
 		want: "Ethernet3/4",
@@ -191,6 +307,282 @@ func TestPort(t *testing.T) {
 	}
 }
 
+func TestPortLanes(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+	breakoutParams := func(channel, numChannels uint) *namer.PortParams {
+		return &namer.PortParams{
+			PortIndex:     1,
+			ChannelIndex:  &channel,
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+			Breakout:      &namer.BreakoutMode{NumChannels: numChannels, ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+		}
+	}
+	qsfp28BreakoutParams := func(channel, numChannels uint) *namer.PortParams {
+		pp := breakoutParams(channel, numChannels)
+		pp.Speed = oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB
+		pp.Breakout.ChannelSpeed = oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB
+		return pp
+	}
+
+	tests := []struct {
+		desc          string
+		hardwareModel string
+		pp            *namer.PortParams
+		want          string
+		wantErr       bool
+		// wantRange is the kind of the IndexOutOfRangeError, if any.
+		wantRange namer.EntityKind
+	}{{
+		desc:          "8 lanes - 4x - first channel",
+		hardwareModel: "7060DX5-64S",
+		pp:            breakoutParams(0, 4),
+		want:          "Ethernet1/1",
+	}, {
+		desc:          "8 lanes - 4x - last channel",
+		hardwareModel: "7060DX5-64S",
+		pp:            breakoutParams(3, 4),
+		want:          "Ethernet1/7",
+	}, {
+		desc:          "8 lanes - 2x",
+		hardwareModel: "7060PX4-32",
+		pp:            breakoutParams(1, 2),
+		want:          "Ethernet1/5",
+	}, {
+		desc:          "8 lanes - 8x",
+		hardwareModel: "7060DX5-64S",
+		pp:            breakoutParams(5, 8),
+		want:          "Ethernet1/6",
+	}, {
+		desc:          "4 lanes - 4x",
+		hardwareModel: "7060CX-32S",
+		pp:            breakoutParams(2, 4),
+		want:          "Ethernet1/3",
+	}, {
+		desc:          "4 lanes - 2x",
+		hardwareModel: "7060CX-32S",
+		pp:            breakoutParams(1, 2),
+		want:          "Ethernet1/3",
+	}, {
+		desc:          "4 lanes - too many channels",
+		hardwareModel: "7060CX-32S",
+		pp:            breakoutParams(0, 8),
+		wantErr:       true,
+	}, {
+		desc:          "8 lanes - uneven channels",
+		hardwareModel: "7060DX5-64S",
+		pp:            breakoutParams(0, 3),
+		wantErr:       true,
+	}, {
+		desc:          "7280R3 QSFP-DD - 4x - last channel",
+		hardwareModel: "DCS-7280DR3-24",
+		pp:            breakoutParams(3, 4),
+		want:          "Ethernet1/7",
+	}, {
+		desc:          "7280R3 OSFP - 8x",
+		hardwareModel: "DCS-7280PR3-24",
+		pp:            breakoutParams(7, 8),
+		want:          "Ethernet1/8",
+	}, {
+		desc:          "7280R3 mixed - 400G port - 4x - last channel",
+		hardwareModel: "DCS-7280CR3-32P4",
+		pp:            breakoutParams(3, 4),
+		want:          "Ethernet1/7",
+	}, {
+		desc:          "7280R3 mixed - 100G port - 4x - last channel",
+		hardwareModel: "DCS-7280CR3-32P4",
+		pp:            qsfp28BreakoutParams(3, 4),
+		want:          "Ethernet1/4",
+	}, {
+		desc:          "7280R3 mixed - 100G port - too many channels",
+		hardwareModel: "DCS-7280CR3-32D4",
+		pp:            qsfp28BreakoutParams(0, 8),
+		wantErr:       true,
+	}, {
+		desc:          "7280R3 QSFP28 - 2x",
+		hardwareModel: "DCS-7280SR3-48YC8",
+		pp:            qsfp28BreakoutParams(1, 2),
+		want:          "Ethernet1/3",
+	}, {
+		desc:          "4 lanes - unknown breakout beyond lanes",
+		hardwareModel: "7060CX-32S",
+		pp: &namer.PortParams{
+			PortIndex:     1,
+			ChannelIndex:  uintPtr(4),
+			Channelizable: true,
+		},
+		wantErr:   true,
+		wantRange: namer.KindChannel,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			n := &Namer{HardwareModel: test.hardwareModel}
+			got, err := n.Port(test.pp)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Port(%v) got error %v, want error %v", test.pp, err, test.wantErr)
+			}
+			var rangeErr *namer.IndexOutOfRangeError
+			if test.wantRange != "" && (!errors.As(err, &rangeErr) || rangeErr.Entity != test.wantRange) {
+				t.Errorf("Port(%v) got error %v, want an IndexOutOfRangeError for %s", test.pp, err, test.wantRange)
+			}
+			if got != test.want {
+				t.Errorf("Port(%v) got %q, want %q", test.pp, got, test.want)
+			}
+		})
+	}
+}
+
+func TestParsePort(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc string
+		name string
+		want *namer.PortParams
+	}{{
+		desc: "unchannelizable",
+		name: "Ethernet4/3",
+		want: &namer.PortParams{
+			SlotIndex: uintPtr(1),
+			PortIndex: 3,
+		},
+	}, {
+		desc: "channelizable",
+		name: "Ethernet4/3/1",
+		want: &namer.PortParams{
+			SlotIndex:     uintPtr(1),
+			PortIndex:     3,
+			Channelizable: true,
+		},
+	}, {
+		desc: "channelized",
+		name: "Ethernet4/3/4",
+		want: &namer.PortParams{
+			SlotIndex:     uintPtr(1),
+			PortIndex:     3,
+			ChannelIndex:  uintPtr(3),
+			Channelizable: true,
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := an.ParsePort(test.name)
+			if err != nil {
+				t.Fatalf("ParsePort(%q) got error: %v", test.name, err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ParsePort(%q) got unexpected diff (-want +got):\n%s", test.name, diff)
+			}
+		})
+	}
+
+	for _, name := range []string{"Ethernet2/3", "Ethernet4", "Ethernet04/3", "Port-Channel1"} {
+		t.Run("invalid "+name, func(t *testing.T) {
+			if _, err := an.ParsePort(name); err == nil || !strings.Contains(err.Error(), name) {
+				t.Fatalf("ParsePort(%q) got error %v, want substring %q", name, err, name)
+			}
+		})
+	}
+}
+
+func TestTransceiver(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc string
+		pp   *namer.PortParams
+		want string
+	}{{
+		desc: "modular",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		want: "Ethernet4/2",
+	}, {
+		desc: "channelized",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: "Ethernet4/2",
+	}, {
+		desc: "fixed form factor",
+		pp:   &namer.PortParams{PortIndex: 2},
+		want: "Ethernet2",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := an.Transceiver(test.pp)
+			if err != nil {
+				t.Fatalf("Transceiver(%v) got error: %v", test.pp, err)
+			}
+			if got != test.want {
+				t.Errorf("Transceiver(%v) got %q, want %q", test.pp, got, test.want)
+			}
+		})
+	}
+}
+
+func TestOpticalChannel(t *testing.T) {
+	pp := &namer.PortParams{PortIndex: 1}
+	if _, err := an.OpticalChannel(pp, 0); !errors.Is(err, namer.ErrUnsupportedEntity) {
+		t.Errorf("OpticalChannel(%v,0) got error %v, want %v", pp, err, namer.ErrUnsupportedEntity)
+	}
+}
+
+func TestLogicalChannelIndex(t *testing.T) {
+	pp := &namer.PortParams{PortIndex: 1}
+	if _, err := an.LogicalChannelIndex(pp, namer.LogicalChannelCoherent); !errors.Is(err, namer.ErrUnsupportedEntity) {
+		t.Errorf("LogicalChannelIndex(%v,%v) got error %v, want %v", pp, namer.LogicalChannelCoherent, err, namer.ErrUnsupportedEntity)
+	}
+}
+
+func TestPortASIC(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc          string
+		hardwareModel string
+		pp            *namer.PortParams
+		want          string
+		wantErr       bool
+	}{{
+		desc: "modular",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 13},
+		want: "Linecard4/Chip3",
+	}, {
+		desc: "channelized",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 13, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: "Linecard4/Chip3",
+	}, {
+		desc:          "fixed form factor",
+		hardwareModel: "7060CX-32S",
+		pp:            &namer.PortParams{PortIndex: 2},
+		want:          "Chip1",
+	}, {
+		desc:          "fixed form factor second chip",
+		hardwareModel: "DCS-7280CR3-32P4",
+		pp:            &namer.PortParams{PortIndex: 30},
+		want:          "Chip2",
+	}, {
+		desc:          "linecard slot on fixed form factor",
+		hardwareModel: "DCS-7280CR3-32P4",
+		pp:            &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		wantErr:       true,
+	}, {
+		desc:    "port beyond last integrated circuit",
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 36},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			n := &Namer{HardwareModel: test.hardwareModel}
+			got, err := n.PortASIC(test.pp)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("PortASIC(%v) got error %v, want error %v", test.pp, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("PortASIC(%v) got %q, want %q", test.pp, got, test.want)
+			}
+		})
+	}
+}
+
 func TestLinecard(t *testing.T) {
 	tests := []struct {
 		desc  string
@@ -223,6 +615,13 @@ func TestLinecard(t *testing.T) {
 			t.Fatalf("Linecard(8) got error %v, want substring %q", err, wantErr)
 		}
 	})
+
+	t.Run("fixed form factor", func(t *testing.T) {
+		n := &Namer{HardwareModel: "DCS-7280CR3-32P4"}
+		if _, err := n.Linecard(0); !errors.Is(err, namer.ErrUnsupportedEntity) {
+			t.Errorf("Linecard(0) got error %v, want %v", err, namer.ErrUnsupportedEntity)
+		}
+	})
 }
 
 func TestControllerCard(t *testing.T) {
@@ -291,4 +690,383 @@ func TestFabric(t *testing.T) {
 			t.Fatalf("Fabric(6) got error %v, want substring %q", err, wantErr)
 		}
 	})
+
+	t.Run("fixed form factor", func(t *testing.T) {
+		n := &Namer{HardwareModel: "DCS-7280CR3-32P4"}
+		if _, err := n.Fabric(0); !errors.Is(err, namer.ErrUnsupportedEntity) {
+			t.Errorf("Fabric(0) got error %v, want %v", err, namer.ErrUnsupportedEntity)
+		}
+	})
+}
+
+func TestManagementInterface(t *testing.T) {
+	tests := []struct {
+		desc           string
+		hardwareModel  string
+		controllerCard uint
+		index          uint
+		want           string
+		wantErr        bool
+	}{{
+		desc:           "modular",
+		hardwareModel:  "7800R3",
+		controllerCard: 1,
+		index:          0,
+		want:           "Management2/1",
+	}, {
+		desc:           "fixed",
+		hardwareModel:  "7060CX-32S",
+		controllerCard: 0,
+		index:          1,
+		want:           "Management2",
+	}, {
+		desc:           "fixed - second controller card",
+		hardwareModel:  "7060CX-32S",
+		controllerCard: 1,
+		index:          0,
+		wantErr:        true,
+	}, {
+		desc:           "over max",
+		controllerCard: 0,
+		index:          2,
+		wantErr:        true,
+	}, {
+		desc:           "invalid controller card",
+		controllerCard: 2,
+		index:          0,
+		wantErr:        true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			n := &Namer{HardwareModel: test.hardwareModel}
+			got, err := n.ManagementInterface(test.controllerCard, test.index)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ManagementInterface(%d,%d) got error %v, want error %v", test.controllerCard, test.index, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("ManagementInterface(%d,%d) got %q, want %q", test.controllerCard, test.index, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPowerSupplyAndFans(t *testing.T) {
+	tests := []struct {
+		desc          string
+		hardwareModel string
+		nameFn        func(*Namer) (string, error)
+		want          string
+		wantErr       bool
+	}{{
+		desc:          "power supply - fixed",
+		hardwareModel: "7060CX-32S",
+		nameFn:        func(n *Namer) (string, error) { return n.PowerSupply(1) },
+		want:          "PowerSupply2",
+	}, {
+		desc:          "power supply - fixed over max",
+		hardwareModel: "7060CX-32S",
+		nameFn:        func(n *Namer) (string, error) { return n.PowerSupply(2) },
+		wantErr:       true,
+	}, {
+		desc:          "power supply - modular",
+		hardwareModel: "7800R3",
+		nameFn:        func(n *Namer) (string, error) { return n.PowerSupply(11) },
+		want:          "PowerSupply12",
+	}, {
+		desc:          "fan tray",
+		hardwareModel: "7800R3",
+		nameFn:        func(n *Namer) (string, error) { return n.FanTray(0) },
+		want:          "FanTray1",
+	}, {
+		desc:          "fan",
+		hardwareModel: "7800R3",
+		nameFn:        func(n *Namer) (string, error) { return n.Fan(1, 2) },
+		want:          "Fan2/3",
+	}, {
+		desc:          "fan over max",
+		hardwareModel: "7060CX-32S",
+		nameFn:        func(n *Namer) (string, error) { return n.Fan(0, 1) },
+		wantErr:       true,
+	}, {
+		desc:          "fan in invalid tray",
+		hardwareModel: "7060CX-32S",
+		nameFn:        func(n *Namer) (string, error) { return n.Fan(4, 0) },
+		wantErr:       true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn(&Namer{HardwareModel: test.hardwareModel})
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestIntegratedCircuitAndCPU(t *testing.T) {
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr bool
+	}{{
+		desc:   "integrated circuit",
+		nameFn: func() (string, error) { return an.IntegratedCircuit(1, 1) },
+		want:   "Linecard4/Chip2",
+	}, {
+		desc:    "integrated circuit over max",
+		nameFn:  func() (string, error) { return an.IntegratedCircuit(0, maxIntegratedCircuitIndex+1) },
+		wantErr: true,
+	}, {
+		desc:    "integrated circuit on invalid linecard",
+		nameFn:  func() (string, error) { return an.IntegratedCircuit(8, 0) },
+		wantErr: true,
+	}, {
+		desc:   "integrated circuit on fixed form factor",
+		nameFn: func() (string, error) { return (&Namer{HardwareModel: "DCS-7280CR3-32P4"}).IntegratedCircuit(0, 1) },
+		want:   "Chip2",
+	}, {
+		desc:    "integrated circuit off chassis of fixed form factor",
+		nameFn:  func() (string, error) { return (&Namer{HardwareModel: "DCS-7280CR3-32P4"}).IntegratedCircuit(1, 0) },
+		wantErr: true,
+	}, {
+		desc:   "controller card CPU",
+		nameFn: func() (string, error) { return an.CPU(namer.KindControllerCard, 0, 0) },
+		want:   "Supervisor1/CPU0",
+	}, {
+		desc:   "linecard CPU",
+		nameFn: func() (string, error) { return an.CPU(namer.KindLinecard, 0, 0) },
+		want:   "Linecard3/CPU0",
+	}, {
+		desc:    "CPU over max",
+		nameFn:  func() (string, error) { return an.CPU(namer.KindLinecard, 0, maxCPUIndex+1) },
+		wantErr: true,
+	}, {
+		desc:    "CPU on fabric",
+		nameFn:  func() (string, error) { return an.CPU(namer.KindFabric, 0, 0) },
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestChassisAndBackplane(t *testing.T) {
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr bool
+	}{{
+		desc:   "chassis",
+		nameFn: func() (string, error) { return an.Chassis(0) },
+		want:   "Chassis",
+	}, {
+		desc:    "chassis over max",
+		nameFn:  func() (string, error) { return an.Chassis(1) },
+		wantErr: true,
+	}, {
+		desc:    "backplane",
+		nameFn:  func() (string, error) { return an.Backplane(0) },
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseIndex(t *testing.T) {
+	tests := []struct {
+		desc string
+		kind namer.EntityKind
+		name string
+		want uint
+	}{{
+		desc: "PowerSupply1",
+		kind: namer.KindPowerSupply,
+		name: "PowerSupply1",
+		want: 0,
+	}, {
+		desc: "FanTray1",
+		kind: namer.KindFanTray,
+		name: "FanTray1",
+		want: 0,
+	}, {
+		desc: "Vlan100",
+		kind: namer.KindVLANInterface,
+		name: "Vlan100",
+		want: 100,
+	}, {
+		desc: "Loopback7",
+		kind: namer.KindLoopback,
+		name: "Loopback7",
+		want: 7,
+	}, {
+		desc: "Port-Channel1",
+		kind: namer.KindAggregate,
+		name: "Port-Channel1",
+		want: 0,
+	}, {
+		desc: "Port-Channel5",
+		kind: namer.KindAggregateMember,
+		name: "Port-Channel5",
+		want: 4,
+	}, {
+		desc: "Linecard10",
+		kind: namer.KindLinecard,
+		name: "Linecard10",
+		want: 7,
+	}, {
+		desc: "Supervisor2",
+		kind: namer.KindControllerCard,
+		name: "Supervisor2",
+		want: 1,
+	}, {
+		desc: "Fabric1",
+		kind: namer.KindFabric,
+		name: "Fabric1",
+		want: 0,
+	}, {
+		desc: "Chassis",
+		kind: namer.KindChassis,
+		name: "Chassis",
+		want: 0,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := an.ParseIndex(test.kind, test.name)
+			if err != nil {
+				t.Fatalf("ParseIndex(%v,%q) got error: %v", test.kind, test.name, err)
+			}
+			if got != test.want {
+				t.Errorf("ParseIndex(%v,%q) got %d, want %d", test.kind, test.name, got, test.want)
+			}
+		})
+	}
+
+	invalidTests := []struct {
+		kind namer.EntityKind
+		name string
+	}{
+		{namer.KindLoopback, "Loopback1001"},
+		{namer.KindAggregate, "Port-Channel0"},
+		{namer.KindLoopback, "Loopback07"},
+		{namer.KindLinecard, "Linecard2"},
+		{namer.KindFabric, "Linecard3"},
+		{namer.KindChassis, "Chassis1"},
+	}
+	for _, test := range invalidTests {
+		t.Run("invalid "+test.name, func(t *testing.T) {
+			if _, err := an.ParseIndex(test.kind, test.name); err == nil {
+				t.Fatalf("ParseIndex(%v,%q) got no error", test.kind, test.name)
+			}
+		})
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	got, err := an.Capabilities()
+	if err != nil {
+		t.Fatalf("Capabilities() got error: %v", err)
+	}
+	if got.MaxLoopbacks != 1001 {
+		t.Errorf("Capabilities() got MaxLoopbacks %d, want 1001", got.MaxLoopbacks)
+	}
+	if got.MaxAggregates != 999999 {
+		t.Errorf("Capabilities() got MaxAggregates %d, want 999999", got.MaxAggregates)
+	}
+	if got.MaxLinecards != 8 {
+		t.Errorf("Capabilities() got MaxLinecards %d, want 8", got.MaxLinecards)
+	}
+	if got.MaxControllerCards != 2 {
+		t.Errorf("Capabilities() got MaxControllerCards %d, want 2", got.MaxControllerCards)
+	}
+	if got.MaxFabrics != 6 {
+		t.Errorf("Capabilities() got MaxFabrics %d, want 6", got.MaxFabrics)
+	}
+	if got.MaxPowerSupplies != 12 {
+		t.Errorf("Capabilities() got MaxPowerSupplies %d, want 12", got.MaxPowerSupplies)
+	}
+	if got.MaxFanTrays != 12 {
+		t.Errorf("Capabilities() got MaxFanTrays %d, want 12", got.MaxFanTrays)
+	}
+
+	fixed := &Namer{HardwareModel: "DCS-7280CR3-32P4"}
+	got, err = fixed.Capabilities()
+	if err != nil {
+		t.Fatalf("Capabilities() of %s got error: %v", fixed.HardwareModel, err)
+	}
+	if got.MaxLinecards != 0 || got.MaxControllerCards != 1 || got.MaxFabrics != 0 {
+		t.Errorf("Capabilities() of %s got %d linecards, %d controller cards, and %d fabrics, want 0, 1, and 0", fixed.HardwareModel, got.MaxLinecards, got.MaxControllerCards, got.MaxFabrics)
+	}
+}
+
+func TestIsFixedFormFactor(t *testing.T) {
+	tests := []struct {
+		hardwareModel string
+		want          bool
+	}{
+		{"7060CX-32S", true},
+		{"7280R3", true},
+		{"7800R3", false},
+		{"DCS-7280CR3-32P4", true},
+		{"DCS-7060DX5-64S", true},
+		{"ccs-7280sr3-48yc8", true},
+		{"DCS-7808-CH", false},
+		{"", false},
+		{"unknown", false},
+	}
+	for _, test := range tests {
+		n := &Namer{HardwareModel: test.hardwareModel}
+		if got := n.IsFixedFormFactor(); got != test.want {
+			t.Errorf("IsFixedFormFactor() with hardware model %q got %v, want %v", test.hardwareModel, got, test.want)
+		}
+	}
+}
+
+func TestUnlistedHardwareModel(t *testing.T) {
+	for _, hwm := range []string{"", "DCS-7280CR3-32P4", "DCS-7060DX5-64S", "DCS-7808-CH", "7800R3", "7280R3"} {
+		n := &Namer{HardwareModel: hwm}
+		if _, err := n.Capabilities(); err != nil {
+			t.Errorf("Capabilities() with hardware model %q got error: %v", hwm, err)
+		}
+	}
+	for _, hwm := range []string{"DCS-7150S-24", "7500R", "7500R3"} {
+		n := &Namer{HardwareModel: hwm}
+		if _, err := n.LoopbackInterface(0); err != nil {
+			t.Errorf("LoopbackInterface(0) with hardware model %q got error: %v", hwm, err)
+		}
+		if _, err := n.AggregateInterface(0); err != nil {
+			t.Errorf("AggregateInterface(0) with hardware model %q got error: %v", hwm, err)
+		}
+		if _, err := n.Capabilities(); !errors.Is(err, namer.ErrUnsupportedHardwareModel) {
+			t.Errorf("Capabilities() with hardware model %q got error %v, want %v", hwm, err, namer.ErrUnsupportedHardwareModel)
+		}
+	}
+}
+
+func TestConformance(t *testing.T) {
+	namertest.TestNamer(t, an)
+	t.Run("fixed form factor", func(t *testing.T) {
+		namertest.TestNamer(t, &Namer{HardwareModel: "7060CX-32S"})
+	})
 }
@@ -17,12 +17,15 @@ package ciena
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
-	"github.com/openconfig/entity-naming/internal/namer"
+	"github.com/openconfig/entity-naming/internal/namerutil"
+	"github.com/openconfig/entity-naming/namer"
+	"github.com/openconfig/entity-naming/oc"
 )
 
-var _ namer.Namer = (*Namer)(nil)
+var _ namerutil.Namer = (*Namer)(nil)
 
 const (
 	hardwareModelWR13 = "WR13"
@@ -30,6 +33,36 @@ const (
 	hardwareModelWR2  = "WR2"
 )
 
+const (
+	maxLoopbackIndex       = 509
+	maxAggregateIndex      = 255
+	maxOpticalChannelIndex = 1
+	// maxChassisIndex is the largest zero-based index of a chassis of a
+	// multi-chassis system that Ciena names, chassis-32.
+	maxChassisIndex = 31
+	// slotsPerChassis is the number of slots numbered in each chassis.
+	slotsPerChassis = 16
+	// maxLogicalChannelSlot is the largest slot index of a port with a logical
+	// channel index: that of the last slot of the last chassis, which must fit
+	// in the three digits that logicalChannelBases leave for it.
+	maxLogicalChannelSlot = (maxChassisIndex + 1) * slotsPerChassis
+)
+
+// logicalChannelBases give each kind of Ciena logical channel its own
+// millions, which leaves room for slot indices of up to three digits.
+var logicalChannelBases = map[namer.LogicalChannelKind]uint32{
+	namer.LogicalChannelEthernet: 1000000,
+	namer.LogicalChannelOTN:      2000000,
+	namer.LogicalChannelCoherent: 3000000,
+}
+
+var portSpeeds = []oc.E_IfEthernet_ETHERNET_SPEED{
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+}
+
 // Namer is an Ciena implementation of the Namer interface.
 type Namer struct {
 	HardwareModel string // WR13 (default), WR7, or WR2
@@ -37,18 +70,16 @@ type Namer struct {
 
 // LoopbackInterface is an implementation of namer.LoopbackInterface.
 func (n *Namer) LoopbackInterface(index uint) (string, error) {
-	const maxIndex = 509
-	if index > maxIndex {
-		return "", fmt.Errorf("ciena loopback index cannot exceed %d, got %d", maxIndex, index)
+	if index > maxLoopbackIndex {
+		return "", fmt.Errorf("ciena %w", &namer.IndexOutOfRangeError{Entity: namer.KindLoopback, Index: int(index), Max: maxLoopbackIndex})
 	}
 	return fmt.Sprintf("loop%d", index), nil
 }
 
 // AggregateInterface is an implementation of namer.AggregateInterface.
 func (n *Namer) AggregateInterface(index uint) (string, error) {
-	const maxIndex = 255
-	if index > maxIndex {
-		return "", fmt.Errorf("ciena aggregate index cannot exceed %d, got %d", maxIndex, index)
+	if index > maxAggregateIndex {
+		return "", fmt.Errorf("ciena %w", &namer.IndexOutOfRangeError{Entity: namer.KindAggregate, Index: int(index), Max: maxAggregateIndex})
 	}
 	return fmt.Sprintf("agg%d", index+1), nil
 }
@@ -58,23 +89,103 @@ func (n *Namer) AggregateMemberInterface(index uint) (string, error) {
 	return n.AggregateInterface(index)
 }
 
+// Subinterface is an implementation of namer.Subinterface.
+func (n *Namer) Subinterface(string, uint) (string, error) {
+	return "", fmt.Errorf("ciena subinterfaces are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
+// VLANInterface is an implementation of namer.VLANInterface.
+func (n *Namer) VLANInterface(uint) (string, error) {
+	return "", fmt.Errorf("ciena VLAN interfaces are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
+// TunnelInterface is an implementation of namer.TunnelInterface.
+func (n *Namer) TunnelInterface(tp *namer.TunnelParams) (string, error) {
+	return "", fmt.Errorf("ciena does not support %s tunnel interfaces: %w", tp.Kind, namer.ErrUnsupportedEntity)
+}
+
+// ManagementInterface is an implementation of namer.ManagementInterface.
+func (n *Namer) ManagementInterface(uint, uint) (string, error) {
+	return "", fmt.Errorf("ciena management interfaces are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
+// PowerSupply is an implementation of namer.PowerSupply.
+func (n *Namer) PowerSupply(uint) (string, error) {
+	return "", fmt.Errorf("ciena power supplies are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
+// FanTray is an implementation of namer.FanTray.
+func (n *Namer) FanTray(uint) (string, error) {
+	return "", fmt.Errorf("ciena fan trays are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
+// Fan is an implementation of namer.Fan.
+func (n *Namer) Fan(uint, uint) (string, error) {
+	return "", fmt.Errorf("ciena fans are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
+// IntegratedCircuit is an implementation of namer.IntegratedCircuit.
+func (n *Namer) IntegratedCircuit(uint, uint) (string, error) {
+	return "", fmt.Errorf("ciena integrated circuits are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
+// CPU is an implementation of namer.CPU.
+func (n *Namer) CPU(namer.EntityKind, uint, uint) (string, error) {
+	return "", fmt.Errorf("ciena CPUs are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
+// Chassis is an implementation of namer.Chassis.
+// Chassis are numbered from 1, matching the hardware index of their slots.
+func (n *Namer) Chassis(index uint) (string, error) {
+	if index > maxChassisIndex {
+		return "", fmt.Errorf("ciena %w", &namer.IndexOutOfRangeError{Entity: namer.KindChassis, Index: int(index), Max: maxChassisIndex})
+	}
+	return fmt.Sprintf("chassis-%d", index+1), nil
+}
+
+// Backplane is an implementation of namer.Backplane.
+func (n *Namer) Backplane(uint) (string, error) {
+	return "", fmt.Errorf("ciena backplanes are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
 // calculateSlotIndices calculates the hardware and slot indices from a linear index.
 // hIndex represents the hardware/chassis index, sIndex represents the slot index.
+// Slots are numbered from 1, so index zero yields the invalid slot index zero.
 func calculateSlotIndices(index uint) (hIndex, sIndex uint) {
-	hIndex = ((index - 1) / 16) + 1
-	sIndex = ((index - 1) % 16) + 1
+	if index == 0 {
+		return 0, 0
+	}
+	hIndex = ((index - 1) / slotsPerChassis) + 1
+	sIndex = ((index - 1) % slotsPerChassis) + 1
 	return hIndex, sIndex
 }
 
+// slotIndexError returns the error for an index whose slot does not hold an
+// entity of the given kind.
+func slotIndexError(kind namer.EntityKind, index uint) error {
+	return &namer.IndexOutOfRangeError{Entity: kind, Index: int(index), Max: -1}
+}
+
+// hardwareModel returns the normalized hardware model of the device, which
+// defaults to WR13. Case and the choice of separators are ignored.
+func (n *Namer) hardwareModel() string {
+	if n.HardwareModel == "" {
+		return hardwareModelWR13
+	}
+	return namerutil.NormalizeHardwareModel(n.HardwareModel)
+}
+
+// unsupportedHardwareModelError returns the error for an unsupported
+// hardware model.
+func unsupportedHardwareModelError(hardwareModel string) error {
+	return fmt.Errorf("%w: %s (supported: WR13, WR7, WR2)", namer.ErrUnsupportedHardwareModel, hardwareModel)
+}
+
 // Linecard is an implementation of namer.Linecard.
 func (n *Namer) Linecard(index uint) (string, error) {
 	hIndex, sIndex := calculateSlotIndices(index)
 
-	// Default to WR13 if HardwareModel is not set
-	hardwareModel := n.HardwareModel
-	if hardwareModel == "" {
-		hardwareModel = hardwareModelWR13
-	}
+	hardwareModel := n.hardwareModel()
 
 	switch hardwareModel {
 	case hardwareModelWR2:
@@ -83,7 +194,7 @@ func (n *Namer) Linecard(index uint) (string, error) {
 		case 4, 5:
 			return fmt.Sprintf("ib-%d/%d", hIndex, sIndex), nil
 		default:
-			return "", fmt.Errorf("ciena linecard slot index for WR2 must be in [4,5], got %d", sIndex)
+			return "", fmt.Errorf("ciena linecard slot index for WR2 must be in [4,5], got %d: %w", sIndex, slotIndexError(namer.KindLinecard, index))
 		}
 	case hardwareModelWR7:
 		// For WR7, linecards are at slots 4, 5, 6, and 7
@@ -91,7 +202,7 @@ func (n *Namer) Linecard(index uint) (string, error) {
 		case 4, 5, 6, 7:
 			return fmt.Sprintf("ib-%d/%d", hIndex, sIndex), nil
 		default:
-			return "", fmt.Errorf("ciena linecard slot index for WR7 must be in [4,5,6,7], got %d", sIndex)
+			return "", fmt.Errorf("ciena linecard slot index for WR7 must be in [4,5,6,7], got %d: %w", sIndex, slotIndexError(namer.KindLinecard, index))
 		}
 	case hardwareModelWR13:
 		// For WR13, linecards are at slots 1-6 and 10-11
@@ -99,10 +210,10 @@ func (n *Namer) Linecard(index uint) (string, error) {
 		case 1, 2, 3, 4, 5, 6, 10, 11:
 			return fmt.Sprintf("ib-%d/%d", hIndex, sIndex), nil
 		default:
-			return "", fmt.Errorf("ciena linecard slot index for WR13 must be in [1-6,10,11], got %d", sIndex)
+			return "", fmt.Errorf("ciena linecard slot index for WR13 must be in [1-6,10,11], got %d: %w", sIndex, slotIndexError(namer.KindLinecard, index))
 		}
 	default:
-		return "", fmt.Errorf("unsupported hardware model: %s (supported: WR13, WR7, WR2)", hardwareModel)
+		return "", unsupportedHardwareModelError(hardwareModel)
 	}
 }
 
@@ -110,11 +221,7 @@ func (n *Namer) Linecard(index uint) (string, error) {
 func (n *Namer) ControllerCard(index uint) (string, error) {
 	hIndex, sIndex := calculateSlotIndices(index)
 
-	// Default to WR13 if HardwareModel is not set
-	hardwareModel := n.HardwareModel
-	if hardwareModel == "" {
-		hardwareModel = hardwareModelWR13
-	}
+	hardwareModel := n.hardwareModel()
 
 	switch hardwareModel {
 	case hardwareModelWR7, hardwareModelWR2:
@@ -123,7 +230,7 @@ func (n *Namer) ControllerCard(index uint) (string, error) {
 		case 2, 3:
 			return fmt.Sprintf("ctm-%d/%d", hIndex, sIndex), nil
 		default:
-			return "", fmt.Errorf("ciena Controller Card slot index for %s must be in [2,3], got %d", hardwareModel, sIndex)
+			return "", fmt.Errorf("ciena Controller Card slot index for %s must be in [2,3], got %d: %w", hardwareModel, sIndex, slotIndexError(namer.KindControllerCard, index))
 		}
 	case hardwareModelWR13:
 		// For WR13, controller cards are at slots 7 and 8
@@ -131,10 +238,10 @@ func (n *Namer) ControllerCard(index uint) (string, error) {
 		case 7, 8:
 			return fmt.Sprintf("ctm-%d/%d", hIndex, sIndex), nil
 		default:
-			return "", fmt.Errorf("ciena Controller Card slot index for WR13 must be in [7,8], got %d", sIndex)
+			return "", fmt.Errorf("ciena Controller Card slot index for WR13 must be in [7,8], got %d: %w", sIndex, slotIndexError(namer.KindControllerCard, index))
 		}
 	default:
-		return "", fmt.Errorf("unsupported hardware model: %s (supported: WR13, WR7, WR2)", hardwareModel)
+		return "", unsupportedHardwareModelError(hardwareModel)
 	}
 }
 
@@ -142,23 +249,19 @@ func (n *Namer) ControllerCard(index uint) (string, error) {
 func (n *Namer) Fabric(index uint) (string, error) {
 	hIndex, sIndex := calculateSlotIndices(index)
 
-	// Default to WR13 if HardwareModel is not set
-	hardwareModel := n.HardwareModel
-	if hardwareModel == "" {
-		hardwareModel = hardwareModelWR13
-	}
+	hardwareModel := n.hardwareModel()
 
 	switch hardwareModel {
 	case hardwareModelWR2:
 		// For WR2, fabric cards are not supported
-		return "", fmt.Errorf("ciena Fabric is not supported for WR2")
+		return "", fmt.Errorf("ciena Fabric is not supported for WR2: %w", namer.ErrUnsupportedEntity)
 	case hardwareModelWR7:
 		// For WR7, fabric cards are at slots 8, 9, and 10
 		switch sIndex {
 		case 8, 9, 10:
 			return fmt.Sprintf("fb-%d/%d", hIndex, sIndex), nil
 		default:
-			return "", fmt.Errorf("ciena Fabric slot index for WR7 must be in [8,9,10], got %d", sIndex)
+			return "", fmt.Errorf("ciena Fabric slot index for WR7 must be in [8,9,10], got %d: %w", sIndex, slotIndexError(namer.KindFabric, index))
 		}
 	case hardwareModelWR13:
 		// For WR13, fabric cards are at slots 12-16
@@ -166,15 +269,21 @@ func (n *Namer) Fabric(index uint) (string, error) {
 		case 12, 13, 14, 15, 16:
 			return fmt.Sprintf("fb-%d/%d", hIndex, sIndex), nil
 		default:
-			return "", fmt.Errorf("ciena Fabric slot index for WR13 must be in [12-16], got %d", sIndex)
+			return "", fmt.Errorf("ciena Fabric slot index for WR13 must be in [12-16], got %d: %w", sIndex, slotIndexError(namer.KindFabric, index))
 		}
 	default:
-		return "", fmt.Errorf("unsupported hardware model: %s (supported: WR13, WR7, WR2)", hardwareModel)
+		return "", unsupportedHardwareModelError(hardwareModel)
 	}
 }
 
 // Port is an implementation of namer.Port.
+// The leading index of the name is the channel, and the unchannelized port
+// takes the name of channel 1. The channels of a breakout would therefore
+// include the name of the port they replace, so breakout modes are rejected.
 func (n *Namer) Port(pp *namer.PortParams) (string, error) {
+	if pp.ChannelIndex != nil && pp.Breakout != nil {
+		return "", fmt.Errorf("ciena breakout %v is not supported: %w", pp.Breakout, namer.ErrUnsupportedEntity)
+	}
 	var nameBuilder strings.Builder
 	if pp.ChannelIndex == nil {
 		nameBuilder.WriteString("1/")
@@ -189,12 +298,146 @@ func (n *Namer) Port(pp *namer.PortParams) (string, error) {
 	return nameBuilder.String(), nil
 }
 
+var portRE = regexp.MustCompile(`^(\d+)/(\d+)/(\d+)$`)
+
+// ParsePort is an implementation of namer.ParsePort.
+// Ciena names the channel with index 1 the same as the unchannelized port,
+// so a leading 1 is parsed as unchannelized.
+func (n *Namer) ParsePort(name string) (*namer.PortParams, error) {
+	indices, ok := namerutil.MatchIndices(portRE, name)
+	if !ok {
+		return nil, fmt.Errorf("ciena port name %q is invalid", name)
+	}
+	pp := &namer.PortParams{
+		SlotIndex:     indices[1],
+		PortIndex:     *indices[2],
+		Channelizable: true,
+	}
+	if channel := indices[0]; *channel != 1 {
+		pp.ChannelIndex = channel
+	}
+	return pp, nil
+}
+
+// Transceiver is an implementation of namer.Transceiver.
+func (n *Namer) Transceiver(*namer.PortParams) (string, error) {
+	return "", fmt.Errorf("ciena transceivers are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
+// OpticalChannel is an implementation of namer.OpticalChannel.
+// Optical channels are named by their one-based number followed by the
+// slot and port, so optical channel index 0 of port 1/4/3 is "och-1/4/3".
+func (n *Namer) OpticalChannel(pp *namer.PortParams, index uint) (string, error) {
+	if index > maxOpticalChannelIndex {
+		return "", fmt.Errorf("ciena %w", &namer.IndexOutOfRangeError{Entity: namer.KindOpticalChannel, Index: int(index), Max: maxOpticalChannelIndex})
+	}
+	unchannelized := *pp
+	unchannelized.ChannelIndex = nil
+	port, err := n.Port(&unchannelized)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("och-%d/%s", index+1, strings.TrimPrefix(port, "1/")), nil
+}
+
+// LogicalChannelIndex is an implementation of namer.LogicalChannelIndex.
+// The index packs the slot, port, and channel of the port into its digits.
+func (n *Namer) LogicalChannelIndex(pp *namer.PortParams, kind namer.LogicalChannelKind) (uint32, error) {
+	base, ok := logicalChannelBases[kind]
+	if !ok {
+		return 0, fmt.Errorf("ciena does not support %s logical channels: %w", kind, namer.ErrUnsupportedEntity)
+	}
+	var slot uint
+	if pp.SlotIndex != nil {
+		slot = *pp.SlotIndex
+	}
+	if slot > maxLogicalChannelSlot {
+		return 0, &namer.IndexOutOfRangeError{Entity: namer.KindSlot, Index: int(slot), Max: maxLogicalChannelSlot}
+	}
+	return namerutil.PackLogicalChannelIndex(base, slot, pp)
+}
+
+// PortASIC is an implementation of namer.PortASIC.
+func (n *Namer) PortASIC(*namer.PortParams) (string, error) {
+	return "", fmt.Errorf("ciena port integrated circuits are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
+var (
+	loopbackRE       = regexp.MustCompile(`^loop(\d+)$`)
+	aggregateRE      = regexp.MustCompile(`^agg(\d+)$`)
+	linecardRE       = regexp.MustCompile(`^ib-(\d+)/(\d+)$`)
+	controllerCardRE = regexp.MustCompile(`^ctm-(\d+)/(\d+)$`)
+	fabricRE         = regexp.MustCompile(`^fb-(\d+)/(\d+)$`)
+	chassisRE        = regexp.MustCompile(`^chassis-(\d+)$`)
+)
+
+// ParseIndex is an implementation of namer.ParseIndex.
+func (n *Namer) ParseIndex(kind namer.EntityKind, name string) (uint, error) {
+	switch kind {
+	case namer.KindLoopback:
+		return namerutil.InvertIndex(name, loopbackRE, 0, n.LoopbackInterface)
+	case namer.KindAggregate:
+		return namerutil.InvertIndex(name, aggregateRE, 1, n.AggregateInterface)
+	case namer.KindAggregateMember:
+		return namerutil.InvertIndex(name, aggregateRE, 1, n.AggregateMemberInterface)
+	case namer.KindLinecard:
+		return parseSlotIndex(name, linecardRE, n.Linecard)
+	case namer.KindControllerCard:
+		return parseSlotIndex(name, controllerCardRE, n.ControllerCard)
+	case namer.KindFabric:
+		return parseSlotIndex(name, fabricRE, n.Fabric)
+	case namer.KindChassis:
+		return namerutil.InvertIndex(name, chassisRE, 1, n.Chassis)
+	}
+	return 0, fmt.Errorf("ciena cannot parse the index of a %s: %w", kind, namer.ErrUnsupportedEntity)
+}
+
+// parseSlotIndex is the inverse of calculateSlotIndices for a name matched by
+// a regular expression with hardware and slot index subexpressions.
+func parseSlotIndex(name string, re *regexp.Regexp, nameFn func(uint) (string, error)) (uint, error) {
+	indices, ok := namerutil.MatchIndices(re, name)
+	if !ok || *indices[0] == 0 || *indices[1] == 0 || *indices[1] > 16 {
+		return 0, fmt.Errorf("name %q does not match %v", name, re)
+	}
+	index := (*indices[0]-1)*16 + *indices[1]
+	got, err := nameFn(index)
+	if err != nil {
+		return 0, err
+	}
+	if got != name {
+		return 0, fmt.Errorf("name %q does not match %v", name, re)
+	}
+	return index, nil
+}
+
 // IsFixedFormFactor is an implementation of namer.IsFixedFormFactor.
+// All supported Ciena hardware models are modular.
 func (n *Namer) IsFixedFormFactor() bool {
-	// TODO(Ciena): Fill in this implementation.
 	return false
 }
 
+// Capabilities is an implementation of namer.Capabilities.
+// The linecard and fabric counts are per chassis.
+func (n *Namer) Capabilities() (*namer.Capabilities, error) {
+	caps := &namer.Capabilities{
+		MaxLoopbacks:       maxLoopbackIndex + 1,
+		MaxAggregates:      maxAggregateIndex + 1,
+		MaxControllerCards: 2,
+		PortSpeeds:         portSpeeds,
+	}
+	switch n.hardwareModel() {
+	case hardwareModelWR13:
+		caps.MaxLinecards, caps.MaxFabrics = 8, 5
+	case hardwareModelWR7:
+		caps.MaxLinecards, caps.MaxFabrics = 4, 3
+	case hardwareModelWR2:
+		caps.MaxLinecards, caps.MaxFabrics = 2, 0
+	default:
+		return nil, unsupportedHardwareModelError(n.HardwareModel)
+	}
+	return caps, nil
+}
+
 // CommonQoSQueues is an implementation of namer.CommonQoSQueues.
 func (n *Namer) CommonQoSQueues(*namer.QoSParams) (*namer.CommonQoSQueueNames, error) {
 	return &namer.CommonQoSQueueNames{
@@ -15,10 +15,13 @@
 package ciena
 
 import (
+	"errors"
 	"strings"
 	"testing"
 
-	"github.com/openconfig/entity-naming/internal/namer"
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/entity-naming/namer"
+	"github.com/openconfig/entity-naming/namer/namertest"
 )
 
 var cn = new(Namer)
@@ -54,6 +57,10 @@ func TestLoopbackInterface(t *testing.T) {
 		if wantErr := "exceed"; err == nil || !strings.Contains(err.Error(), wantErr) {
 			t.Fatalf("LoopbackInterface(256) got error %v, want substring %q", err, wantErr)
 		}
+		var rangeErr *namer.IndexOutOfRangeError
+		if !errors.As(err, &rangeErr) || rangeErr.Entity != namer.KindLoopback || rangeErr.Max != maxLoopbackIndex {
+			t.Errorf("LoopbackInterface(256) got error %v, want an IndexOutOfRangeError with max %d", err, maxLoopbackIndex)
+		}
 	})
 }
 
@@ -125,6 +132,44 @@ func TestAggregateMemberInterface(t *testing.T) {
 	})
 }
 
+func TestSubinterface(t *testing.T) {
+	_, err := cn.Subinterface("1/1/1", 1)
+	if !errors.Is(err, namer.ErrUnsupportedEntity) {
+		t.Errorf("Subinterface(%q,1) got error %v, want %v", "1/1/1", err, namer.ErrUnsupportedEntity)
+	}
+}
+
+func TestVLANInterface(t *testing.T) {
+	_, err := cn.VLANInterface(100)
+	if !errors.Is(err, namer.ErrUnsupportedEntity) {
+		t.Errorf("VLANInterface(100) got error %v, want %v", err, namer.ErrUnsupportedEntity)
+	}
+}
+
+func TestTunnelInterface(t *testing.T) {
+	tests := []struct {
+		desc    string
+		tp      *namer.TunnelParams
+		want    string
+		wantErr bool
+	}{{
+		desc:    "GRE",
+		tp:      &namer.TunnelParams{Kind: namer.TunnelGRE, Index: 0},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.TunnelInterface(test.tp)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("TunnelInterface(%v) got error %v, want error %v", test.tp, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("TunnelInterface(%v) got %q, want %q", test.tp, got, test.want)
+			}
+		})
+	}
+}
+
 func TestPort(t *testing.T) {
 	uintPtr := func(i uint) *uint { return &i }
 
@@ -160,6 +205,192 @@ func TestPort(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("breakout", func(t *testing.T) {
+		pp := &namer.PortParams{
+			SlotIndex:     uintPtr(4),
+			PortIndex:     3,
+			ChannelIndex:  uintPtr(2),
+			Channelizable: true,
+			Breakout:      &namer.BreakoutMode{NumChannels: 4},
+		}
+		if _, err := cn.Port(pp); !errors.Is(err, namer.ErrUnsupportedEntity) {
+			t.Errorf("Port(%v) got error %v, want %v", pp, err, namer.ErrUnsupportedEntity)
+		}
+	})
+}
+
+func TestParsePort(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc string
+		name string
+		want *namer.PortParams
+	}{{
+		desc: "unchannelized",
+		name: "1/4/3",
+		want: &namer.PortParams{
+			SlotIndex:     uintPtr(4),
+			PortIndex:     3,
+			Channelizable: true,
+		},
+	}, {
+		desc: "channelized",
+		name: "2/4/3",
+		want: &namer.PortParams{
+			SlotIndex:     uintPtr(4),
+			PortIndex:     3,
+			ChannelIndex:  uintPtr(2),
+			Channelizable: true,
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.ParsePort(test.name)
+			if err != nil {
+				t.Fatalf("ParsePort(%q) got error: %v", test.name, err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ParsePort(%q) got unexpected diff (-want +got):\n%s", test.name, diff)
+			}
+		})
+	}
+
+	for _, name := range []string{"1/4", "1/04/3", "agg1"} {
+		t.Run("invalid "+name, func(t *testing.T) {
+			if _, err := cn.ParsePort(name); err == nil || !strings.Contains(err.Error(), name) {
+				t.Fatalf("ParsePort(%q) got error %v, want substring %q", name, err, name)
+			}
+		})
+	}
+}
+
+func TestTransceiver(t *testing.T) {
+	pp := &namer.PortParams{PortIndex: 1}
+	if _, err := cn.Transceiver(pp); !errors.Is(err, namer.ErrUnsupportedEntity) {
+		t.Errorf("Transceiver(%v) got error %v, want %v", pp, err, namer.ErrUnsupportedEntity)
+	}
+}
+
+func TestOpticalChannel(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc    string
+		pp      *namer.PortParams
+		index   uint
+		want    string
+		wantErr bool
+	}{{
+		desc: "first optical channel",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 1},
+		want: "och-1/1/1",
+	}, {
+		desc:  "second optical channel",
+		pp:    &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 1},
+		index: 1,
+		want:  "och-2/1/1",
+	}, {
+		desc: "channelized",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(2), PortIndex: 3, ChannelIndex: uintPtr(4), Channelizable: true},
+		want: "och-1/2/3",
+	}, {
+		desc:    "index over max",
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 1},
+		index:   2,
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.OpticalChannel(test.pp, test.index)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("OpticalChannel(%v,%d) got error %v, want error %v", test.pp, test.index, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("OpticalChannel(%v,%d) got %q, want %q", test.pp, test.index, got, test.want)
+			}
+		})
+	}
+}
+
+func TestLogicalChannelIndex(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc    string
+		kind    namer.LogicalChannelKind
+		pp      *namer.PortParams
+		want    uint32
+		wantErr bool
+		// wantRange is the kind of the IndexOutOfRangeError, if any.
+		wantRange namer.EntityKind
+	}{{
+		desc: "ethernet",
+		kind: namer.LogicalChannelEthernet,
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		want: 1001020,
+	}, {
+		desc: "OTN channel",
+		kind: namer.LogicalChannelOTN,
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: 2001024,
+	}, {
+		desc: "coherent",
+		kind: namer.LogicalChannelCoherent,
+		pp:   &namer.PortParams{SlotIndex: uintPtr(20), PortIndex: 35},
+		want: 3020350,
+	}, {
+		desc: "last slot of the last chassis",
+		kind: namer.LogicalChannelEthernet,
+		pp:   &namer.PortParams{SlotIndex: uintPtr(maxLogicalChannelSlot), PortIndex: 99, ChannelIndex: uintPtr(8), Channelizable: true},
+		want: 1512999,
+	}, {
+		desc:      "port index too large",
+		kind:      namer.LogicalChannelEthernet,
+		pp:        &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 100},
+		wantErr:   true,
+		wantRange: namer.KindPort,
+	}, {
+		desc:      "channel index too large",
+		kind:      namer.LogicalChannelOTN,
+		pp:        &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2, ChannelIndex: uintPtr(9), Channelizable: true},
+		wantErr:   true,
+		wantRange: namer.KindChannel,
+	}, {
+		desc:      "slot index too large",
+		kind:      namer.LogicalChannelEthernet,
+		pp:        &namer.PortParams{SlotIndex: uintPtr(maxLogicalChannelSlot + 1)},
+		wantErr:   true,
+		wantRange: namer.KindSlot,
+	}, {
+		desc:    "unknown kind",
+		kind:    namer.LogicalChannelKind("unknown"),
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.LogicalChannelIndex(test.pp, test.kind)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("LogicalChannelIndex(%v,%v) got error %v, want error %v", test.pp, test.kind, err, test.wantErr)
+			}
+			var rangeErr *namer.IndexOutOfRangeError
+			if test.wantRange != "" && (!errors.As(err, &rangeErr) || rangeErr.Entity != test.wantRange) {
+				t.Errorf("LogicalChannelIndex(%v,%v) got error %v, want an IndexOutOfRangeError for %s", test.pp, test.kind, err, test.wantRange)
+			}
+			if got != test.want {
+				t.Errorf("LogicalChannelIndex(%v,%v) got %d, want %d", test.pp, test.kind, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPortASIC(t *testing.T) {
+	pp := &namer.PortParams{PortIndex: 1}
+	if _, err := cn.PortASIC(pp); !errors.Is(err, namer.ErrUnsupportedEntity) {
+		t.Errorf("PortASIC(%v) got error %v, want %v", pp, err, namer.ErrUnsupportedEntity)
+	}
 }
 
 func TestLinecard(t *testing.T) {
@@ -440,6 +671,11 @@ func TestFabric(t *testing.T) {
 		hardwareModel: "WR2",
 		index:         1,
 		wantErr:       true,
+	}, {
+		desc:          "WR13 - index zero",
+		hardwareModel: "WR13",
+		index:         0,
+		wantErr:       true,
 	}, {
 		desc:          "WR13 - invalid slot 8",
 		hardwareModel: "WR13",
@@ -487,12 +723,24 @@ func TestFabric(t *testing.T) {
 		}
 	})
 
+	t.Run("slot without a fabric", func(t *testing.T) {
+		cn := &Namer{HardwareModel: "WR7"}
+		_, err := cn.Fabric(11)
+		var rangeErr *namer.IndexOutOfRangeError
+		if !errors.As(err, &rangeErr) || rangeErr.Entity != namer.KindFabric || rangeErr.Index != 11 {
+			t.Errorf("Fabric(11) with WR7 got error %v, want an IndexOutOfRangeError for index 11", err)
+		}
+	})
+
 	t.Run("WR2 not supported", func(t *testing.T) {
-		namer := &Namer{HardwareModel: "WR2"}
-		_, err := namer.Fabric(12)
+		cn := &Namer{HardwareModel: "WR2"}
+		_, err := cn.Fabric(12)
 		if wantErr := "not supported for WR2"; err == nil || !strings.Contains(err.Error(), wantErr) {
 			t.Fatalf("Fabric with WR2 got error %v, want substring %q", err, wantErr)
 		}
+		if !errors.Is(err, namer.ErrUnsupportedEntity) {
+			t.Errorf("Fabric with WR2 got error %v, want %v", err, namer.ErrUnsupportedEntity)
+		}
 	})
 
 	t.Run("unsupported hardware model", func(t *testing.T) {
@@ -503,3 +751,212 @@ func TestFabric(t *testing.T) {
 		}
 	})
 }
+
+func TestManagementInterface(t *testing.T) {
+	_, err := cn.ManagementInterface(0, 0)
+	if !errors.Is(err, namer.ErrUnsupportedEntity) {
+		t.Errorf("ManagementInterface(0,0) got error %v, want %v", err, namer.ErrUnsupportedEntity)
+	}
+}
+
+func TestPowerSupplyAndFans(t *testing.T) {
+	for desc, nameFn := range map[string]func() (string, error){
+		"power supply": func() (string, error) { return cn.PowerSupply(0) },
+		"fan tray":     func() (string, error) { return cn.FanTray(0) },
+		"fan":          func() (string, error) { return cn.Fan(0, 0) },
+	} {
+		if _, err := nameFn(); !errors.Is(err, namer.ErrUnsupportedEntity) {
+			t.Errorf("%s got error %v, want %v", desc, err, namer.ErrUnsupportedEntity)
+		}
+	}
+}
+
+func TestIntegratedCircuitAndCPU(t *testing.T) {
+	for desc, nameFn := range map[string]func() (string, error){
+		"integrated circuit": func() (string, error) { return cn.IntegratedCircuit(4, 0) },
+		"CPU":                func() (string, error) { return cn.CPU(namer.KindControllerCard, 7, 0) },
+	} {
+		if _, err := nameFn(); !errors.Is(err, namer.ErrUnsupportedEntity) {
+			t.Errorf("%s got error %v, want %v", desc, err, namer.ErrUnsupportedEntity)
+		}
+	}
+}
+
+func TestChassisAndBackplane(t *testing.T) {
+	tests := []struct {
+		desc  string
+		index uint
+		want  string
+	}{
+		{"first chassis", 0, "chassis-1"},
+		{"second chassis", 1, "chassis-2"},
+		{"last chassis", maxChassisIndex, "chassis-32"},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.Chassis(test.index)
+			if err != nil {
+				t.Fatalf("Chassis(%d) got error %v", test.index, err)
+			}
+			if got != test.want {
+				t.Errorf("Chassis(%d) got %q, want %q", test.index, got, test.want)
+			}
+		})
+	}
+	var rangeErr *namer.IndexOutOfRangeError
+	if _, err := cn.Chassis(maxChassisIndex + 1); !errors.As(err, &rangeErr) || rangeErr.Entity != namer.KindChassis {
+		t.Errorf("Chassis(%d) got error %v, want chassis IndexOutOfRangeError", maxChassisIndex+1, err)
+	}
+	if _, err := cn.Backplane(0); !errors.Is(err, namer.ErrUnsupportedEntity) {
+		t.Errorf("Backplane(0) got error %v, want %v", err, namer.ErrUnsupportedEntity)
+	}
+}
+
+func TestParseIndex(t *testing.T) {
+	tests := []struct {
+		desc string
+		kind namer.EntityKind
+		name string
+		want uint
+	}{{
+		desc: "loop9",
+		kind: namer.KindLoopback,
+		name: "loop9",
+		want: 9,
+	}, {
+		desc: "agg1",
+		kind: namer.KindAggregate,
+		name: "agg1",
+		want: 0,
+	}, {
+		desc: "agg256",
+		kind: namer.KindAggregateMember,
+		name: "agg256",
+		want: 255,
+	}, {
+		desc: "ib-1/4",
+		kind: namer.KindLinecard,
+		name: "ib-1/4",
+		want: 4,
+	}, {
+		desc: "ib-2/1",
+		kind: namer.KindLinecard,
+		name: "ib-2/1",
+		want: 17,
+	}, {
+		desc: "ctm-1/7",
+		kind: namer.KindControllerCard,
+		name: "ctm-1/7",
+		want: 7,
+	}, {
+		desc: "fb-1/16",
+		kind: namer.KindFabric,
+		name: "fb-1/16",
+		want: 16,
+	}, {
+		desc: "chassis-2",
+		kind: namer.KindChassis,
+		name: "chassis-2",
+		want: 1,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.ParseIndex(test.kind, test.name)
+			if err != nil {
+				t.Fatalf("ParseIndex(%v,%q) got error: %v", test.kind, test.name, err)
+			}
+			if got != test.want {
+				t.Errorf("ParseIndex(%v,%q) got %d, want %d", test.kind, test.name, got, test.want)
+			}
+		})
+	}
+
+	invalidTests := []struct {
+		kind namer.EntityKind
+		name string
+	}{
+		{namer.KindAggregate, "agg0"},
+		{namer.KindLinecard, "ib-1/8"},
+		{namer.KindLinecard, "ib-0/4"},
+		{namer.KindFabric, "fb-1/17"},
+		{namer.KindChassis, "chassis-0"},
+	}
+	for _, test := range invalidTests {
+		t.Run("invalid "+test.name, func(t *testing.T) {
+			if _, err := cn.ParseIndex(test.kind, test.name); err == nil {
+				t.Fatalf("ParseIndex(%v,%q) got no error", test.kind, test.name)
+			}
+		})
+	}
+}
+
+func TestUnlistedHardwareModel(t *testing.T) {
+	for _, hwm := range []string{"", "WR13", "WR7", "WR2", "wr13", " wr7 "} {
+		t.Run("listed "+hwm, func(t *testing.T) {
+			cn := &Namer{HardwareModel: hwm}
+			if _, err := cn.Capabilities(); err != nil {
+				t.Errorf("Capabilities() got error: %v", err)
+			}
+			if _, err := cn.Linecard(4); err != nil {
+				t.Errorf("Linecard(4) got error: %v", err)
+			}
+		})
+	}
+
+	t.Run("unlisted hardware model", func(t *testing.T) {
+		cn := &Namer{HardwareModel: "WR99"}
+		if _, err := cn.LoopbackInterface(0); err != nil {
+			t.Errorf("LoopbackInterface(0) got error: %v", err)
+		}
+		if _, err := cn.AggregateInterface(0); err != nil {
+			t.Errorf("AggregateInterface(0) got error: %v", err)
+		}
+		_, err := cn.Capabilities()
+		if wantErr := "unsupported hardware model"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("Capabilities() got error %v, want substring %q", err, wantErr)
+		}
+		if !errors.Is(err, namer.ErrUnsupportedHardwareModel) {
+			t.Errorf("Capabilities() got error %v, want %v", err, namer.ErrUnsupportedHardwareModel)
+		}
+	})
+}
+
+func TestCapabilities(t *testing.T) {
+	tests := []struct {
+		hardwareModel              string
+		wantLinecards, wantFabrics uint
+	}{
+		{"", 8, 5},
+		{"WR13", 8, 5},
+		{"WR7", 4, 3},
+		{"WR2", 2, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.hardwareModel, func(t *testing.T) {
+			namer := &Namer{HardwareModel: test.hardwareModel}
+			got, err := namer.Capabilities()
+			if err != nil {
+				t.Fatalf("Capabilities() got error: %v", err)
+			}
+			if got.MaxLinecards != test.wantLinecards || got.MaxFabrics != test.wantFabrics {
+				t.Errorf("Capabilities() got %d linecards and %d fabrics, want %d and %d", got.MaxLinecards, got.MaxFabrics, test.wantLinecards, test.wantFabrics)
+			}
+		})
+	}
+
+	t.Run("unsupported hardware model", func(t *testing.T) {
+		namer := &Namer{HardwareModel: "WR99"}
+		_, err := namer.Capabilities()
+		if wantErr := "unsupported hardware model"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("Capabilities() got error %v, want substring %q", err, wantErr)
+		}
+	})
+}
+
+func TestConformance(t *testing.T) {
+	for _, hwm := range []string{"WR13", "WR7", "WR2"} {
+		t.Run(hwm, func(t *testing.T) {
+			namertest.TestNamer(t, &Namer{HardwareModel: hwm})
+		})
+	}
+}
@@ -17,30 +17,122 @@ package cisco
 
 import (
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
 	"strings"
 
-	"github.com/openconfig/entity-naming/internal/namer"
+	"github.com/openconfig/entity-naming/internal/namerutil"
+	"github.com/openconfig/entity-naming/namer"
 	"github.com/openconfig/entity-naming/oc"
 )
 
-var _ namer.Namer = (*Namer)(nil)
+var _ namerutil.Namer = (*Namer)(nil)
+
+const (
+	maxLoopbackIndex          = 2147483647
+	maxAggregateIndex         = 65534
+	maxChassisIndex           = 0
+	maxIntegratedCircuitIndex = 5
+	maxCPUIndex               = 0
+	maxOpticalChannelIndex    = 0
+	maxSubinterfaceIndex      = 2147483647
+	minVLANID                 = 1
+	maxVLANID                 = 4094
+	maxTunnelIndex            = 65535
+	maxManagementIndex        = 1
+)
+
+// logicalChannelBases are the first Cisco logical channel indices of each
+// kind. Each kind has its own hundred thousands, so the leading digit of an
+// index gives its kind.
+var logicalChannelBases = map[namer.LogicalChannelKind]uint32{
+	namer.LogicalChannelEthernet: 100000,
+	namer.LogicalChannelOTN:      200000,
+	namer.LogicalChannelCoherent: 300000,
+}
+
+// hardwareModel describes a family of Cisco hardware models.
+type hardwareModel struct {
+	namerutil.HardwareModel
+	// portSpeeds are the ethernet link speeds of the ports and channels of
+	// the hardware models.
+	portSpeeds []oc.E_IfEthernet_ETHERNET_SPEED
+}
+
+// skuPrefixes are the prefixes of the Cisco product descriptions that
+// inventories may report, such as "Cisco 8201-32FH", that are not part of the
+// hardware model name.
+var skuPrefixes = []string{"CISCO-"}
+
+// hardwareModels are the known Cisco hardware model families: the 8201 fixed
+// router, whose inventories report a PID such as 8201-32FH, and the 8808
+// modular chassis.
+var hardwareModels = []hardwareModel{
+	{
+		HardwareModel: namerutil.HardwareModel{Prefixes: []string{"8201"}, FixedFormFactor: true, NumLinecards: 0, NumControllerCards: 1, NumFabrics: 0, NumPowerSupplies: 2, NumFanTrays: 6, NumFansPerTray: 1, PortsPerASIC: 36},
+		portSpeeds: []oc.E_IfEthernet_ETHERNET_SPEED{
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_50GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_200GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+		},
+	},
+	{
+		HardwareModel: namerutil.HardwareModel{Prefixes: []string{"8808"}, NumLinecards: 8, NumControllerCards: 2, NumFabrics: 8, NumPowerSupplies: 8, NumFanTrays: 4, NumFansPerTray: 3, PortsPerASIC: 12},
+		portSpeeds: []oc.E_IfEthernet_ETHERNET_SPEED{
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_50GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_200GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+			oc.IfEthernet_ETHERNET_SPEED_SPEED_800GB,
+		},
+	},
+}
+
+// defaultHardwareModel describes the device if its hardware model is not
+// given: a chassis laid out like the 8808, with ports of every speed that
+// Cisco can name.
+var defaultHardwareModel = hardwareModel{
+	HardwareModel: namerutil.HardwareModel{NumLinecards: 8, NumControllerCards: 2, NumFabrics: 8, NumPowerSupplies: 8, NumFanTrays: 4, NumFansPerTray: 3, PortsPerASIC: 12},
+	portSpeeds:    slices.Sorted(maps.Keys(speedPrefixes)),
+}
 
 // Namer is a Cisco implementation of the Namer interface.
 type Namer struct {
 	HardwareModel string
 }
 
+// family returns the Cisco family of the hardware model of the device, or
+// the default family if the hardware model is not listed, so that names that
+// do not depend on the hardware model are available for every device.
+// A "Cisco" prefix, case, and the choice of separators in the hardware model
+// are ignored.
+func (n *Namer) family() *hardwareModel {
+	hwm, _ := namerutil.FindHardwareModel(hardwareModels, &defaultHardwareModel, n.HardwareModel, skuPrefixes...)
+	return hwm
+}
+
 // LoopbackInterface is an implementation of namer.LoopbackInterface.
 func (n *Namer) LoopbackInterface(index uint) (string, error) {
+	if index > maxLoopbackIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindLoopback, Index: int(index), Max: maxLoopbackIndex})
+	}
 	return fmt.Sprintf("Loopback%d", index), nil
 }
 
 // AggregateInterface is an implementation of namer.AggregateInterface.
 func (n *Namer) AggregateInterface(index uint) (string, error) {
-	const maxIndex = 65534
-	if index > maxIndex {
+	if index > maxAggregateIndex {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Cisco aggregate index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindAggregate, Index: int(index), Max: maxAggregateIndex})
 	}
 	return fmt.Sprintf("Bundle-Ether%d", index+1), nil
 }
@@ -50,50 +142,204 @@ func (n *Namer) AggregateMemberInterface(index uint) (string, error) {
 	return n.AggregateInterface(index)
 }
 
+// Subinterface is an implementation of namer.Subinterface.
+func (n *Namer) Subinterface(parent string, index uint) (string, error) {
+	if index > maxSubinterfaceIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindSubinterface, Index: int(index), Max: maxSubinterfaceIndex})
+	}
+	return fmt.Sprintf("%s.%d", parent, index), nil
+}
+
+// VLANInterface is an implementation of namer.VLANInterface.
+func (n *Namer) VLANInterface(vlanID uint) (string, error) {
+	if vlanID < minVLANID || vlanID > maxVLANID {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindVLANInterface, Index: int(vlanID), Max: maxVLANID})
+	}
+	return fmt.Sprintf("BVI%d", vlanID), nil
+}
+
+// TunnelInterface is an implementation of namer.TunnelInterface.
+// Cisco names GRE and IP-in-IP tunnels alike, as the tunnel mode is configured
+// on the interface.
+func (n *Namer) TunnelInterface(tp *namer.TunnelParams) (string, error) {
+	var prefix string
+	switch tp.Kind {
+	case namer.TunnelGRE, namer.TunnelIPinIP:
+		prefix = "tunnel-ip"
+	case namer.TunnelMPLSTE:
+		prefix = "tunnel-te"
+	default:
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco does not support %s tunnel interfaces: %w", tp.Kind, namer.ErrUnsupportedEntity)
+	}
+	if tp.Index > maxTunnelIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindTunnelInterface, Index: int(tp.Index), Max: maxTunnelIndex})
+	}
+	return fmt.Sprintf("%s%d", prefix, tp.Index), nil
+}
+
+// ManagementInterface is an implementation of namer.ManagementInterface.
+func (n *Namer) ManagementInterface(controllerCardIndex, index uint) (string, error) {
+	if _, err := n.ControllerCard(controllerCardIndex); err != nil {
+		return "", err
+	}
+	if index > maxManagementIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindManagementInterface, Index: int(index), Max: maxManagementIndex})
+	}
+	return fmt.Sprintf("MgmtEth0/RP%d/CPU0/%d", controllerCardIndex, index), nil
+}
+
 // Linecard is an implementation of namer.Linecard.
 func (n *Namer) Linecard(index uint) (string, error) {
-	const maxIndex = 7
-	if index > maxIndex {
+	hwm := n.family()
+	if hwm.NumLinecards == 0 {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco fixed form factor routers have no linecards: %w", namer.ErrUnsupportedEntity)
+	}
+	if index >= hwm.NumLinecards {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Cisco linecard index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindLinecard, Index: int(index), Max: int(hwm.NumLinecards) - 1})
 	}
 	return fmt.Sprintf("0/%d/CPU0", index), nil
 }
 
 // ControllerCard is an implementation of namer.ControllerCard.
 func (n *Namer) ControllerCard(index uint) (string, error) {
-	const maxIndex = 1
-	if index > maxIndex {
+	hwm := n.family()
+	if index >= hwm.NumControllerCards {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Cisco controller card index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindControllerCard, Index: int(index), Max: int(hwm.NumControllerCards) - 1})
 	}
 	return fmt.Sprintf("0/RP%d/CPU0", index), nil
 }
 
 // Fabric is an implementation of namer.Fabric.
 func (n *Namer) Fabric(index uint) (string, error) {
-	const maxIndex = 7
-	if index > maxIndex {
+	hwm := n.family()
+	if hwm.NumFabrics == 0 {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco fixed form factor routers have no fabrics: %w", namer.ErrUnsupportedEntity)
+	}
+	if index >= hwm.NumFabrics {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Cisco fabric index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindFabric, Index: int(index), Max: int(hwm.NumFabrics) - 1})
 	}
 	return fmt.Sprintf("0/FC%d", index), nil
 }
 
-var speedStrings = map[oc.E_IfEthernet_ETHERNET_SPEED]string{
-	oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB:  "TenGig",
-	oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB: "HundredGig",
-	oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB: "FourHundredGig",
+// speedPrefixes are the prefixes of the names of ports of each speed.
+var speedPrefixes = map[oc.E_IfEthernet_ETHERNET_SPEED]string{
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB:   "GigabitEthernet",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB:  "TenGigE",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB:  "TwentyFiveGigE",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB:  "FortyGigE",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_50GB:  "FiftyGigE",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB: "HundredGigE",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_200GB: "TwoHundredGigE",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB: "FourHundredGigE",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_800GB: "EightHundredGigE",
+}
+
+// PowerSupply is an implementation of namer.PowerSupply.
+func (n *Namer) PowerSupply(index uint) (string, error) {
+	hwm := n.family()
+	if index >= hwm.NumPowerSupplies {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindPowerSupply, Index: int(index), Max: int(hwm.NumPowerSupplies) - 1})
+	}
+	return fmt.Sprintf("0/PM%d", index), nil
+}
+
+// FanTray is an implementation of namer.FanTray.
+func (n *Namer) FanTray(index uint) (string, error) {
+	hwm := n.family()
+	if index >= hwm.NumFanTrays {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindFanTray, Index: int(index), Max: int(hwm.NumFanTrays) - 1})
+	}
+	return fmt.Sprintf("0/FT%d", index), nil
+}
+
+// Fan is an implementation of namer.Fan.
+func (n *Namer) Fan(trayIndex, fanIndex uint) (string, error) {
+	if _, err := n.FanTray(trayIndex); err != nil {
+		return "", err
+	}
+	hwm := n.family()
+	if fanIndex >= hwm.NumFansPerTray {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindFan, Index: int(fanIndex), Max: int(hwm.NumFansPerTray) - 1})
+	}
+	return fmt.Sprintf("0/FT%d-FAN_%d", trayIndex, fanIndex), nil
+}
+
+// IntegratedCircuit is an implementation of namer.IntegratedCircuit.
+// A fixed form factor router has no linecards, and IOS XR names its NPUs
+// after the route processor, so linecard index zero stands for RP0.
+func (n *Namer) IntegratedCircuit(linecardIndex, chipIndex uint) (string, error) {
+	card := n.Linecard
+	if n.IsFixedFormFactor() {
+		if linecardIndex > 0 {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindLinecard, Index: int(linecardIndex), Max: 0})
+		}
+		card = n.ControllerCard
+	}
+	lc, err := card(linecardIndex)
+	if err != nil {
+		return "", err
+	}
+	if chipIndex > maxIntegratedCircuitIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindIntegratedCircuit, Index: int(chipIndex), Max: maxIntegratedCircuitIndex})
+	}
+	return fmt.Sprintf("%s-NPU%d", lc, chipIndex), nil
+}
+
+// CPU is an implementation of namer.CPU.
+// Cisco names each card after its CPU, so the CPU component is given a suffix
+// to keep it distinct from its card.
+func (n *Namer) CPU(cardKind namer.EntityKind, cardIndex, cpuIndex uint) (string, error) {
+	card, err := namerutil.Card(n, cardKind, cardIndex)
+	if err != nil {
+		return "", err
+	}
+	if cpuIndex > maxCPUIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindCPU, Index: int(cpuIndex), Max: maxCPUIndex})
+	}
+	return fmt.Sprintf("%s-CPU%d", card, cpuIndex), nil
+}
+
+// Chassis is an implementation of namer.Chassis.
+func (n *Namer) Chassis(index uint) (string, error) {
+	if index > maxChassisIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindChassis, Index: int(index), Max: maxChassisIndex})
+	}
+	return fmt.Sprintf("Rack %d", index), nil
+}
+
+// Backplane is an implementation of namer.Backplane.
+func (n *Namer) Backplane(uint) (string, error) {
+	//nolint:staticcheck // ST1005 string begins with proper noun
+	return "", fmt.Errorf("Cisco backplanes are not supported: %w", namer.ErrUnsupportedEntity)
 }
 
 // Port is an implementation of namer.Port.
+// Cisco names each channel of a port with the speed of the channel.
 func (n *Namer) Port(pp *namer.PortParams) (string, error) {
-	speed, ok := speedStrings[pp.Speed]
-	if !ok {
-		return "", fmt.Errorf("no known string for port speed %v", pp.Speed)
+	channelSpeed := pp.ChannelSpeed()
+	if !slices.Contains(n.family().portSpeeds, channelSpeed) {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.UnsupportedSpeedError{Speed: channelSpeed, HardwareModel: n.HardwareModel})
 	}
 	var nameBuilder strings.Builder
-	nameBuilder.WriteString(speed + "E0/")
+	nameBuilder.WriteString(speedPrefixes[channelSpeed] + "0/")
 	if pp.SlotIndex == nil {
 		nameBuilder.WriteString("0")
 	} else {
@@ -106,10 +352,165 @@ func (n *Namer) Port(pp *namer.PortParams) (string, error) {
 	return nameBuilder.String(), nil
 }
 
+var portIndicesRE = regexp.MustCompile(`^0/(\d+)/0/(\d+)(?:/(\d+))?$`)
+
+// ParsePort is an implementation of namer.ParsePort.
+// Cisco does not distinguish unchannelized and unchannelizable port names,
+// so a port without a channel is parsed as channelizable. The speed of a
+// channel is parsed as both the port speed and the channel speed, since the
+// name does not encode the speed of the port.
+func (n *Namer) ParsePort(name string) (*namer.PortParams, error) {
+	prefixLen := strings.IndexAny(name, "0123456789")
+	if prefixLen < 0 {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return nil, fmt.Errorf("Cisco port name %q is invalid", name)
+	}
+	pp := &namer.PortParams{Channelizable: true}
+	for speed, prefix := range speedPrefixes {
+		if name[:prefixLen] == prefix {
+			pp.Speed = speed
+			break
+		}
+	}
+	if pp.Speed == oc.IfEthernet_ETHERNET_SPEED_UNSET {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return nil, fmt.Errorf("Cisco port name %q has no known speed prefix", name)
+	}
+	indices, ok := namerutil.MatchIndices(portIndicesRE, name[prefixLen:])
+	if !ok {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return nil, fmt.Errorf("Cisco port name %q is invalid", name)
+	}
+	if n.IsFixedFormFactor() {
+		if *indices[0] != 0 {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return nil, fmt.Errorf("Cisco port name %q has a non-zero slot on a fixed form factor device", name)
+		}
+	} else {
+		pp.SlotIndex = indices[0]
+	}
+	pp.PortIndex = *indices[1]
+	pp.ChannelIndex = indices[2]
+	if pp.ChannelIndex != nil {
+		pp.Breakout = &namer.BreakoutMode{ChannelSpeed: pp.Speed}
+	}
+	return pp, nil
+}
+
+// Transceiver is an implementation of namer.Transceiver.
+func (n *Namer) Transceiver(pp *namer.PortParams) (string, error) {
+	var slot uint
+	if pp.SlotIndex != nil {
+		slot = *pp.SlotIndex
+	}
+	return fmt.Sprintf("Optics0/%d/0/%d", slot, pp.PortIndex), nil
+}
+
+// OpticalChannel is an implementation of namer.OpticalChannel.
+func (n *Namer) OpticalChannel(pp *namer.PortParams, index uint) (string, error) {
+	if index > maxOpticalChannelIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindOpticalChannel, Index: int(index), Max: maxOpticalChannelIndex})
+	}
+	var slot uint
+	if pp.SlotIndex != nil {
+		slot = *pp.SlotIndex
+	}
+	return fmt.Sprintf("OpticalChannel0/%d/0/%d", slot, pp.PortIndex), nil
+}
+
+// LogicalChannelIndex is an implementation of namer.LogicalChannelIndex.
+// The index packs the slot, port, and channel of the port into its digits.
+func (n *Namer) LogicalChannelIndex(pp *namer.PortParams, kind namer.LogicalChannelKind) (uint32, error) {
+	base, ok := logicalChannelBases[kind]
+	if !ok {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return 0, fmt.Errorf("Cisco does not support %s logical channels: %w", kind, namer.ErrUnsupportedEntity)
+	}
+	var slot uint
+	if pp.SlotIndex != nil {
+		slot = *pp.SlotIndex
+		if numLinecards := n.family().NumLinecards; slot >= numLinecards {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return 0, fmt.Errorf("Cisco %w", &namer.IndexOutOfRangeError{Entity: namer.KindLinecard, Index: int(slot), Max: int(numLinecards) - 1})
+		}
+	}
+	return namerutil.PackLogicalChannelIndex(base, slot, pp)
+}
+
+// PortASIC is an implementation of namer.PortASIC.
+func (n *Namer) PortASIC(pp *namer.PortParams) (string, error) {
+	var linecardIndex uint
+	if pp.SlotIndex != nil {
+		linecardIndex = *pp.SlotIndex
+	}
+	return n.IntegratedCircuit(linecardIndex, pp.PortIndex/n.family().PortsPerASIC)
+}
+
+var (
+	loopbackRE       = regexp.MustCompile(`^Loopback(\d+)$`)
+	aggregateRE      = regexp.MustCompile(`^Bundle-Ether(\d+)$`)
+	linecardRE       = regexp.MustCompile(`^0/(\d+)/CPU0$`)
+	controllerCardRE = regexp.MustCompile(`^0/RP(\d+)/CPU0$`)
+	fabricRE         = regexp.MustCompile(`^0/FC(\d+)$`)
+	vlanInterfaceRE  = regexp.MustCompile(`^BVI(\d+)$`)
+	powerSupplyRE    = regexp.MustCompile(`^0/PM(\d+)$`)
+	fanTrayRE        = regexp.MustCompile(`^0/FT(\d+)$`)
+	chassisRE        = regexp.MustCompile(`^Rack (\d+)$`)
+)
+
+// ParseIndex is an implementation of namer.ParseIndex.
+func (n *Namer) ParseIndex(kind namer.EntityKind, name string) (uint, error) {
+	switch kind {
+	case namer.KindLoopback:
+		return namerutil.InvertIndex(name, loopbackRE, 0, n.LoopbackInterface)
+	case namer.KindAggregate:
+		return namerutil.InvertIndex(name, aggregateRE, 1, n.AggregateInterface)
+	case namer.KindAggregateMember:
+		return namerutil.InvertIndex(name, aggregateRE, 1, n.AggregateMemberInterface)
+	case namer.KindLinecard:
+		return namerutil.InvertIndex(name, linecardRE, 0, n.Linecard)
+	case namer.KindControllerCard:
+		return namerutil.InvertIndex(name, controllerCardRE, 0, n.ControllerCard)
+	case namer.KindFabric:
+		return namerutil.InvertIndex(name, fabricRE, 0, n.Fabric)
+	case namer.KindVLANInterface:
+		return namerutil.InvertIndex(name, vlanInterfaceRE, 0, n.VLANInterface)
+	case namer.KindPowerSupply:
+		return namerutil.InvertIndex(name, powerSupplyRE, 0, n.PowerSupply)
+	case namer.KindFanTray:
+		return namerutil.InvertIndex(name, fanTrayRE, 0, n.FanTray)
+	case namer.KindChassis:
+		return namerutil.InvertIndex(name, chassisRE, 0, n.Chassis)
+	}
+	//nolint:staticcheck // ST1005 string begins with proper noun
+	return 0, fmt.Errorf("Cisco cannot parse the index of a %s: %w", kind, namer.ErrUnsupportedEntity)
+}
+
 // IsFixedFormFactor is an implementation of namer.IsFixedFormFactor.
+// Without a hardware model, the device is assumed to be modular.
 func (n *Namer) IsFixedFormFactor() bool {
-	// TODO(cisco): Fill in this implementation.
-	return false
+	return n.family().FixedFormFactor
+}
+
+// Capabilities is an implementation of namer.Capabilities.
+// The limits of hardware models that are not listed are not known.
+func (n *Namer) Capabilities() (*namer.Capabilities, error) {
+	hwm, err := namerutil.FindHardwareModel(hardwareModels, &defaultHardwareModel, n.HardwareModel, skuPrefixes...)
+	if err != nil {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return nil, fmt.Errorf("Cisco %w", err)
+	}
+	return &namer.Capabilities{
+		MaxLoopbacks:       maxLoopbackIndex + 1,
+		MaxAggregates:      maxAggregateIndex + 1,
+		MaxLinecards:       hwm.NumLinecards,
+		MaxControllerCards: hwm.NumControllerCards,
+		MaxFabrics:         hwm.NumFabrics,
+		MaxPowerSupplies:   hwm.NumPowerSupplies,
+		MaxFanTrays:        hwm.NumFanTrays,
+		PortSpeeds:         slices.Clone(hwm.portSpeeds),
+	}, nil
 }
 
 // CommonQoSQueues is an implementation of namer.CommonQoSQueues.
@@ -15,10 +15,14 @@
 package cisco
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
-	"github.com/openconfig/entity-naming/internal/namer"
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/entity-naming/namer"
+	"github.com/openconfig/entity-naming/namer/namertest"
 	"github.com/openconfig/entity-naming/oc"
 )
 
@@ -37,6 +41,10 @@ func TestLoopbackInterface(t *testing.T) {
 		desc:  "nonzero",
 		index: 1000,
 		want:  "Loopback1000",
+	}, {
+		desc:  "max",
+		index: 2147483647,
+		want:  "Loopback2147483647",
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -49,6 +57,17 @@ func TestLoopbackInterface(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("over max", func(t *testing.T) {
+		_, err := cn.LoopbackInterface(2147483648)
+		if wantErr := "exceed"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("LoopbackInterface(2147483648) got error %v, want substring %q", err, wantErr)
+		}
+		var rangeErr *namer.IndexOutOfRangeError
+		if !errors.As(err, &rangeErr) || rangeErr.Entity != namer.KindLoopback || rangeErr.Max != maxLoopbackIndex {
+			t.Errorf("LoopbackInterface(2147483648) got error %v, want an IndexOutOfRangeError with max %d", err, maxLoopbackIndex)
+		}
+	})
 }
 
 func TestAggregateInterface(t *testing.T) {
@@ -119,6 +138,113 @@ func TestAggregateMemberInterface(t *testing.T) {
 	})
 }
 
+func TestSubinterface(t *testing.T) {
+	tests := []struct {
+		desc  string
+		index uint
+		want  string
+	}{{
+		desc:  "min",
+		index: 0,
+		want:  "HundredGigE0/0/0/1.0",
+	}, {
+		desc:  "max",
+		index: 2147483647,
+		want:  "HundredGigE0/0/0/1.2147483647",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.Subinterface("HundredGigE0/0/0/1", test.index)
+			if err != nil {
+				t.Fatalf("Subinterface(%q,%d) got error: %v", "HundredGigE0/0/0/1", test.index, err)
+			}
+			if got != test.want {
+				t.Errorf("Subinterface(%q,%d) got %q, want %q", "HundredGigE0/0/0/1", test.index, got, test.want)
+			}
+		})
+	}
+
+	t.Run("over max", func(t *testing.T) {
+		_, err := cn.Subinterface("HundredGigE0/0/0/1", 2147483648)
+		if wantErr := "exceed"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("Subinterface(%q,2147483648) got error %v, want substring %q", "HundredGigE0/0/0/1", err, wantErr)
+		}
+	})
+}
+
+func TestVLANInterface(t *testing.T) {
+	tests := []struct {
+		desc   string
+		vlanID uint
+		want   string
+	}{{
+		desc:   "min",
+		vlanID: 1,
+		want:   "BVI1",
+	}, {
+		desc:   "max",
+		vlanID: 4094,
+		want:   "BVI4094",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.VLANInterface(test.vlanID)
+			if err != nil {
+				t.Fatalf("VLANInterface(%d) got error: %v", test.vlanID, err)
+			}
+			if got != test.want {
+				t.Errorf("VLANInterface(%d) got %q, want %q", test.vlanID, got, test.want)
+			}
+		})
+	}
+
+	for _, vlanID := range []uint{0, 4095} {
+		t.Run(fmt.Sprintf("invalid %d", vlanID), func(t *testing.T) {
+			_, err := cn.VLANInterface(vlanID)
+			var rangeErr *namer.IndexOutOfRangeError
+			if !errors.As(err, &rangeErr) || rangeErr.Entity != namer.KindVLANInterface {
+				t.Errorf("VLANInterface(%d) got error %v, want an IndexOutOfRangeError", vlanID, err)
+			}
+		})
+	}
+}
+
+func TestTunnelInterface(t *testing.T) {
+	tests := []struct {
+		desc    string
+		tp      *namer.TunnelParams
+		want    string
+		wantErr bool
+	}{{
+		desc: "GRE",
+		tp:   &namer.TunnelParams{Kind: namer.TunnelGRE, Index: 1},
+		want: "tunnel-ip1",
+	}, {
+		desc: "IP-in-IP",
+		tp:   &namer.TunnelParams{Kind: namer.TunnelIPinIP, Index: 2},
+		want: "tunnel-ip2",
+	}, {
+		desc: "MPLS-TE",
+		tp:   &namer.TunnelParams{Kind: namer.TunnelMPLSTE, Index: 65535},
+		want: "tunnel-te65535",
+	}, {
+		desc:    "over max",
+		tp:      &namer.TunnelParams{Kind: namer.TunnelMPLSTE, Index: 65536},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.TunnelInterface(test.tp)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("TunnelInterface(%v) got error %v, want error %v", test.tp, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("TunnelInterface(%v) got %q, want %q", test.tp, got, test.want)
+			}
+		})
+	}
+}
+
 func TestPort(t *testing.T) {
 	uintPtr := func(i uint) *uint { return &i }
 
@@ -152,6 +278,20 @@ func TestPort(t *testing.T) {
 			Speed:        oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
 		},
 		want: "FourHundredGigE0/1/0/3/4",
+	}, {
+		desc: "channelized with breakout mode",
+		pp: &namer.PortParams{
+			SlotIndex:     uintPtr(0),
+			PortIndex:     1,
+			ChannelIndex:  uintPtr(0),
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+			Channelizable: true,
+			Breakout: &namer.BreakoutMode{
+				NumChannels:  4,
+				ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+			},
+		},
+		want: "HundredGigE0/0/0/1/0",
 	}, {
 		desc: "fixed form factor - unchannelizable",
 		pp: &namer.PortParams{
@@ -176,6 +316,48 @@ func TestPort(t *testing.T) {
 			Channelizable: true,
 		},
 		want: "FourHundredGigE0/0/0/3/4",
+	}, {
+		desc: "gigabit",
+		pp: &namer.PortParams{
+			PortIndex: 3,
+			Speed:     oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB,
+		},
+		want: "GigabitEthernet0/0/0/3",
+	}, {
+		desc: "twenty five gigabit",
+		pp: &namer.PortParams{
+			PortIndex: 3,
+			Speed:     oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB,
+		},
+		want: "TwentyFiveGigE0/0/0/3",
+	}, {
+		desc: "forty gigabit",
+		pp: &namer.PortParams{
+			PortIndex: 3,
+			Speed:     oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB,
+		},
+		want: "FortyGigE0/0/0/3",
+	}, {
+		desc: "fifty gigabit",
+		pp: &namer.PortParams{
+			PortIndex: 3,
+			Speed:     oc.IfEthernet_ETHERNET_SPEED_SPEED_50GB,
+		},
+		want: "FiftyGigE0/0/0/3",
+	}, {
+		desc: "two hundred gigabit",
+		pp: &namer.PortParams{
+			PortIndex: 3,
+			Speed:     oc.IfEthernet_ETHERNET_SPEED_SPEED_200GB,
+		},
+		want: "TwoHundredGigE0/0/0/3",
+	}, {
+		desc: "eight hundred gigabit",
+		pp: &namer.PortParams{
+			PortIndex: 3,
+			Speed:     oc.IfEthernet_ETHERNET_SPEED_SPEED_800GB,
+		},
+		want: "EightHundredGigE0/0/0/3",
 	}}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
@@ -188,6 +370,275 @@ func TestPort(t *testing.T) {
 			}
 		})
 	}
+
+	unsupportedTests := []struct {
+		hardwareModel string
+		speed         oc.E_IfEthernet_ETHERNET_SPEED
+	}{
+		{"", oc.IfEthernet_ETHERNET_SPEED_SPEED_10MB},
+		{"", oc.IfEthernet_ETHERNET_SPEED_SPEED_1600GB},
+		{"8201-32FH", oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB},
+		{"8201-32FH", oc.IfEthernet_ETHERNET_SPEED_SPEED_800GB},
+		{"8808", oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB},
+	}
+	for _, test := range unsupportedTests {
+		t.Run(fmt.Sprintf("unsupported speed %v on %q", test.speed, test.hardwareModel), func(t *testing.T) {
+			n := &Namer{HardwareModel: test.hardwareModel}
+			pp := &namer.PortParams{PortIndex: 3, Speed: test.speed}
+			_, err := n.Port(pp)
+			if !errors.Is(err, namer.ErrUnsupportedSpeed) {
+				t.Fatalf("Port(%v) got error %v, want %v", pp, err, namer.ErrUnsupportedSpeed)
+			}
+			var speedErr *namer.UnsupportedSpeedError
+			if !errors.As(err, &speedErr) {
+				t.Fatalf("Port(%v) got error %v, want an UnsupportedSpeedError", pp, err)
+			}
+			if speedErr.Speed != test.speed || speedErr.HardwareModel != test.hardwareModel {
+				t.Errorf("Port(%v) got error for speed %v and hardware model %q, want %v and %q", pp, speedErr.Speed, speedErr.HardwareModel, test.speed, test.hardwareModel)
+			}
+		})
+	}
+}
+
+func TestParsePort(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc string
+		name string
+		want *namer.PortParams
+	}{{
+		desc: "unchannelized",
+		name: "TenGigE0/1/0/3",
+		want: &namer.PortParams{
+			SlotIndex:     uintPtr(1),
+			PortIndex:     3,
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB,
+		},
+	}, {
+		desc: "channelized",
+		name: "FourHundredGigE0/1/0/3/4",
+		want: &namer.PortParams{
+			SlotIndex:     uintPtr(1),
+			PortIndex:     3,
+			ChannelIndex:  uintPtr(4),
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+			Breakout:      &namer.BreakoutMode{ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB},
+		},
+	}, {
+		desc: "gigabit",
+		name: "GigabitEthernet0/0/0/5",
+		want: &namer.PortParams{
+			SlotIndex:     uintPtr(0),
+			PortIndex:     5,
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB,
+		},
+	}, {
+		desc: "eight hundred gigabit channelized",
+		name: "EightHundredGigE0/2/0/1/0",
+		want: &namer.PortParams{
+			SlotIndex:     uintPtr(2),
+			PortIndex:     1,
+			ChannelIndex:  uintPtr(0),
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_800GB,
+			Breakout:      &namer.BreakoutMode{ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_800GB},
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.ParsePort(test.name)
+			if err != nil {
+				t.Fatalf("ParsePort(%q) got error: %v", test.name, err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ParsePort(%q) got unexpected diff (-want +got):\n%s", test.name, diff)
+			}
+		})
+	}
+
+	for _, name := range []string{"OneGigE0/1/0/3", "HundredGigE1/1/0/3", "HundredGigE0/1/1/3", "Bundle-Ether1"} {
+		t.Run("invalid "+name, func(t *testing.T) {
+			if _, err := cn.ParsePort(name); err == nil || !strings.Contains(err.Error(), name) {
+				t.Fatalf("ParsePort(%q) got error %v, want substring %q", name, err, name)
+			}
+		})
+	}
+}
+
+func TestTransceiver(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc string
+		pp   *namer.PortParams
+		want string
+	}{{
+		desc: "modular",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		want: "Optics0/1/0/2",
+	}, {
+		desc: "channelized",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: "Optics0/1/0/2",
+	}, {
+		desc: "fixed form factor",
+		pp:   &namer.PortParams{PortIndex: 2},
+		want: "Optics0/0/0/2",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.Transceiver(test.pp)
+			if err != nil {
+				t.Fatalf("Transceiver(%v) got error: %v", test.pp, err)
+			}
+			if got != test.want {
+				t.Errorf("Transceiver(%v) got %q, want %q", test.pp, got, test.want)
+			}
+		})
+	}
+}
+
+func TestOpticalChannel(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc    string
+		pp      *namer.PortParams
+		index   uint
+		want    string
+		wantErr bool
+	}{{
+		desc: "modular",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		want: "OpticalChannel0/1/0/2",
+	}, {
+		desc: "channelized",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: "OpticalChannel0/1/0/2",
+	}, {
+		desc: "fixed form factor",
+		pp:   &namer.PortParams{PortIndex: 2},
+		want: "OpticalChannel0/0/0/2",
+	}, {
+		desc:    "index over max",
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		index:   maxOpticalChannelIndex + 1,
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.OpticalChannel(test.pp, test.index)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("OpticalChannel(%v,%d) got error %v, want error %v", test.pp, test.index, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("OpticalChannel(%v,%d) got %q, want %q", test.pp, test.index, got, test.want)
+			}
+		})
+	}
+}
+
+func TestLogicalChannelIndex(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc    string
+		kind    namer.LogicalChannelKind
+		pp      *namer.PortParams
+		want    uint32
+		wantErr bool
+	}{{
+		desc: "ethernet",
+		kind: namer.LogicalChannelEthernet,
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		want: 101020,
+	}, {
+		desc: "OTN channel",
+		kind: namer.LogicalChannelOTN,
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: 201024,
+	}, {
+		desc: "coherent fixed form factor",
+		kind: namer.LogicalChannelCoherent,
+		pp:   &namer.PortParams{PortIndex: 35},
+		want: 300350,
+	}, {
+		desc:    "port index too large",
+		kind:    namer.LogicalChannelEthernet,
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 100},
+		wantErr: true,
+	}, {
+		desc:    "slot index too large",
+		kind:    namer.LogicalChannelEthernet,
+		pp:      &namer.PortParams{SlotIndex: uintPtr(8)},
+		wantErr: true,
+	}, {
+		desc:    "unknown kind",
+		kind:    namer.LogicalChannelKind("unknown"),
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.LogicalChannelIndex(test.pp, test.kind)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("LogicalChannelIndex(%v,%v) got error %v, want error %v", test.pp, test.kind, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("LogicalChannelIndex(%v,%v) got %d, want %d", test.pp, test.kind, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPortASIC(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc          string
+		hardwareModel string
+		pp            *namer.PortParams
+		want          string
+		wantErr       bool
+	}{{
+		desc: "modular",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 13},
+		want: "0/1/CPU0-NPU1",
+	}, {
+		desc: "channelized",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 13, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: "0/1/CPU0-NPU1",
+	}, {
+		desc:          "fixed form factor",
+		hardwareModel: "8201-32FH",
+		pp:            &namer.PortParams{PortIndex: 2},
+		want:          "0/RP0/CPU0-NPU0",
+	}, {
+		desc:          "linecard slot on fixed form factor",
+		hardwareModel: "8201-32FH",
+		pp:            &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		wantErr:       true,
+	}, {
+		desc:    "port beyond last integrated circuit",
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 72},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			n := &Namer{HardwareModel: test.hardwareModel}
+			got, err := n.PortASIC(test.pp)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("PortASIC(%v) got error %v, want error %v", test.pp, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("PortASIC(%v) got %q, want %q", test.pp, got, test.want)
+			}
+		})
+	}
 }
 
 func TestLinecard(t *testing.T) {
@@ -222,6 +673,13 @@ func TestLinecard(t *testing.T) {
 			t.Fatalf("Linecard(8) got error %v, want substring %q", err, wantErr)
 		}
 	})
+
+	t.Run("fixed form factor", func(t *testing.T) {
+		n := &Namer{HardwareModel: "8201-32FH"}
+		if _, err := n.Linecard(0); !errors.Is(err, namer.ErrUnsupportedEntity) {
+			t.Errorf("Linecard(0) got error %v, want %v", err, namer.ErrUnsupportedEntity)
+		}
+	})
 }
 
 func TestControllerCard(t *testing.T) {
@@ -290,4 +748,397 @@ func TestFabric(t *testing.T) {
 			t.Fatalf("Fabric(8) got error %v, want substring %q", err, wantErr)
 		}
 	})
+
+	t.Run("fixed form factor", func(t *testing.T) {
+		n := &Namer{HardwareModel: "8201-32FH"}
+		if _, err := n.Fabric(0); !errors.Is(err, namer.ErrUnsupportedEntity) {
+			t.Errorf("Fabric(0) got error %v, want %v", err, namer.ErrUnsupportedEntity)
+		}
+	})
+}
+
+func TestManagementInterface(t *testing.T) {
+	tests := []struct {
+		desc           string
+		hardwareModel  string
+		controllerCard uint
+		index          uint
+		want           string
+		wantErr        bool
+	}{{
+		desc:           "first",
+		controllerCard: 0,
+		index:          0,
+		want:           "MgmtEth0/RP0/CPU0/0",
+	}, {
+		desc:           "second controller card",
+		hardwareModel:  "8808",
+		controllerCard: 1,
+		index:          1,
+		want:           "MgmtEth0/RP1/CPU0/1",
+	}, {
+		desc:           "over max",
+		controllerCard: 0,
+		index:          2,
+		wantErr:        true,
+	}, {
+		desc:           "invalid controller card",
+		controllerCard: 2,
+		index:          0,
+		wantErr:        true,
+	}, {
+		desc:           "fixed",
+		hardwareModel:  "8201-32FH",
+		controllerCard: 0,
+		index:          1,
+		want:           "MgmtEth0/RP0/CPU0/1",
+	}, {
+		desc:           "fixed - second controller card",
+		hardwareModel:  "8201-32FH",
+		controllerCard: 1,
+		index:          0,
+		wantErr:        true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			n := &Namer{HardwareModel: test.hardwareModel}
+			got, err := n.ManagementInterface(test.controllerCard, test.index)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ManagementInterface(%d,%d) got error %v, want error %v", test.controllerCard, test.index, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("ManagementInterface(%d,%d) got %q, want %q", test.controllerCard, test.index, got, test.want)
+			}
+		})
+	}
+
+	t.Run("fixed - second controller card is out of range", func(t *testing.T) {
+		n := &Namer{HardwareModel: "8201-32FH"}
+		_, err := n.ManagementInterface(1, 0)
+		var rangeErr *namer.IndexOutOfRangeError
+		if !errors.As(err, &rangeErr) || rangeErr.Entity != namer.KindControllerCard || rangeErr.Max != 0 {
+			t.Errorf("ManagementInterface(1,0) got error %v, want controller card IndexOutOfRangeError with max 0", err)
+		}
+	})
+}
+
+func TestPowerSupplyAndFans(t *testing.T) {
+	tests := []struct {
+		desc          string
+		hardwareModel string
+		nameFn        func(*Namer) (string, error)
+		want          string
+		wantErr       bool
+	}{{
+		desc:          "power supply - fixed",
+		hardwareModel: "8201-32FH",
+		nameFn:        func(n *Namer) (string, error) { return n.PowerSupply(1) },
+		want:          "0/PM1",
+	}, {
+		desc:          "power supply - fixed over max",
+		hardwareModel: "8201-32FH",
+		nameFn:        func(n *Namer) (string, error) { return n.PowerSupply(2) },
+		wantErr:       true,
+	}, {
+		desc:          "power supply - modular",
+		hardwareModel: "8808",
+		nameFn:        func(n *Namer) (string, error) { return n.PowerSupply(7) },
+		want:          "0/PM7",
+	}, {
+		desc:          "fan tray",
+		hardwareModel: "8808",
+		nameFn:        func(n *Namer) (string, error) { return n.FanTray(3) },
+		want:          "0/FT3",
+	}, {
+		desc:          "fan",
+		hardwareModel: "8808",
+		nameFn:        func(n *Namer) (string, error) { return n.Fan(1, 2) },
+		want:          "0/FT1-FAN_2",
+	}, {
+		desc:          "fan over max",
+		hardwareModel: "8201-32FH",
+		nameFn:        func(n *Namer) (string, error) { return n.Fan(0, 1) },
+		wantErr:       true,
+	}, {
+		desc:          "fan in invalid tray",
+		hardwareModel: "8808",
+		nameFn:        func(n *Namer) (string, error) { return n.Fan(4, 0) },
+		wantErr:       true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn(&Namer{HardwareModel: test.hardwareModel})
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestIntegratedCircuitAndCPU(t *testing.T) {
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr bool
+	}{{
+		desc:   "integrated circuit",
+		nameFn: func() (string, error) { return cn.IntegratedCircuit(1, 1) },
+		want:   "0/1/CPU0-NPU1",
+	}, {
+		desc:    "integrated circuit over max",
+		nameFn:  func() (string, error) { return cn.IntegratedCircuit(0, maxIntegratedCircuitIndex+1) },
+		wantErr: true,
+	}, {
+		desc:    "integrated circuit on invalid linecard",
+		nameFn:  func() (string, error) { return cn.IntegratedCircuit(8, 0) },
+		wantErr: true,
+	}, {
+		desc:   "integrated circuit on fixed form factor",
+		nameFn: func() (string, error) { return (&Namer{HardwareModel: "8201-SYS"}).IntegratedCircuit(0, 1) },
+		want:   "0/RP0/CPU0-NPU1",
+	}, {
+		desc:    "integrated circuit off chassis of fixed form factor",
+		nameFn:  func() (string, error) { return (&Namer{HardwareModel: "8201-SYS"}).IntegratedCircuit(1, 0) },
+		wantErr: true,
+	}, {
+		desc:   "controller card CPU",
+		nameFn: func() (string, error) { return cn.CPU(namer.KindControllerCard, 0, 0) },
+		want:   "0/RP0/CPU0-CPU0",
+	}, {
+		desc:   "linecard CPU",
+		nameFn: func() (string, error) { return cn.CPU(namer.KindLinecard, 1, 0) },
+		want:   "0/1/CPU0-CPU0",
+	}, {
+		desc:    "CPU over max",
+		nameFn:  func() (string, error) { return cn.CPU(namer.KindLinecard, 0, maxCPUIndex+1) },
+		wantErr: true,
+	}, {
+		desc:    "CPU on fabric",
+		nameFn:  func() (string, error) { return cn.CPU(namer.KindFabric, 0, 0) },
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestChassisAndBackplane(t *testing.T) {
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr bool
+	}{{
+		desc:   "chassis",
+		nameFn: func() (string, error) { return cn.Chassis(0) },
+		want:   "Rack 0",
+	}, {
+		desc:    "chassis over max",
+		nameFn:  func() (string, error) { return cn.Chassis(1) },
+		wantErr: true,
+	}, {
+		desc:    "backplane",
+		nameFn:  func() (string, error) { return cn.Backplane(0) },
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseIndex(t *testing.T) {
+	tests := []struct {
+		desc string
+		kind namer.EntityKind
+		name string
+		want uint
+	}{{
+		desc: "0/PM1",
+		kind: namer.KindPowerSupply,
+		name: "0/PM1",
+		want: 1,
+	}, {
+		desc: "0/FT1",
+		kind: namer.KindFanTray,
+		name: "0/FT1",
+		want: 1,
+	}, {
+		desc: "BVI100",
+		kind: namer.KindVLANInterface,
+		name: "BVI100",
+		want: 100,
+	}, {
+		desc: "Loopback7",
+		kind: namer.KindLoopback,
+		name: "Loopback7",
+		want: 7,
+	}, {
+		desc: "Bundle-Ether1",
+		kind: namer.KindAggregate,
+		name: "Bundle-Ether1",
+		want: 0,
+	}, {
+		desc: "Bundle-Ether5",
+		kind: namer.KindAggregateMember,
+		name: "Bundle-Ether5",
+		want: 4,
+	}, {
+		desc: "0/7/CPU0",
+		kind: namer.KindLinecard,
+		name: "0/7/CPU0",
+		want: 7,
+	}, {
+		desc: "0/RP1/CPU0",
+		kind: namer.KindControllerCard,
+		name: "0/RP1/CPU0",
+		want: 1,
+	}, {
+		desc: "0/FC3",
+		kind: namer.KindFabric,
+		name: "0/FC3",
+		want: 3,
+	}, {
+		desc: "Rack 0",
+		kind: namer.KindChassis,
+		name: "Rack 0",
+		want: 0,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := cn.ParseIndex(test.kind, test.name)
+			if err != nil {
+				t.Fatalf("ParseIndex(%v,%q) got error: %v", test.kind, test.name, err)
+			}
+			if got != test.want {
+				t.Errorf("ParseIndex(%v,%q) got %d, want %d", test.kind, test.name, got, test.want)
+			}
+		})
+	}
+
+	invalidTests := []struct {
+		kind namer.EntityKind
+		name string
+	}{
+		{namer.KindAggregate, "Bundle-Ether0"},
+		{namer.KindLinecard, "0/8/CPU0"},
+		{namer.KindControllerCard, "0/1/CPU0"},
+		{namer.KindFabric, "0/FC03"},
+		{namer.KindChassis, "Rack 1"},
+	}
+	for _, test := range invalidTests {
+		t.Run("invalid "+test.name, func(t *testing.T) {
+			if _, err := cn.ParseIndex(test.kind, test.name); err == nil {
+				t.Fatalf("ParseIndex(%v,%q) got no error", test.kind, test.name)
+			}
+		})
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	got, err := cn.Capabilities()
+	if err != nil {
+		t.Fatalf("Capabilities() got error: %v", err)
+	}
+	if got.MaxLoopbacks != 2147483648 {
+		t.Errorf("Capabilities() got MaxLoopbacks %d, want 2147483648", got.MaxLoopbacks)
+	}
+	if got.MaxAggregates != 65535 {
+		t.Errorf("Capabilities() got MaxAggregates %d, want 65535", got.MaxAggregates)
+	}
+	if got.MaxLinecards != 8 {
+		t.Errorf("Capabilities() got MaxLinecards %d, want 8", got.MaxLinecards)
+	}
+	if got.MaxControllerCards != 2 {
+		t.Errorf("Capabilities() got MaxControllerCards %d, want 2", got.MaxControllerCards)
+	}
+	if got.MaxFabrics != 8 {
+		t.Errorf("Capabilities() got MaxFabrics %d, want 8", got.MaxFabrics)
+	}
+	if got.MaxPowerSupplies != 8 {
+		t.Errorf("Capabilities() got MaxPowerSupplies %d, want 8", got.MaxPowerSupplies)
+	}
+	if got.MaxFanTrays != 4 {
+		t.Errorf("Capabilities() got MaxFanTrays %d, want 4", got.MaxFanTrays)
+	}
+	if len(got.PortSpeeds) != 9 {
+		t.Errorf("Capabilities() got %d PortSpeeds, want 9", len(got.PortSpeeds))
+	}
+
+	fixed := &Namer{HardwareModel: "8201-32FH"}
+	got, err = fixed.Capabilities()
+	if err != nil {
+		t.Fatalf("Capabilities() of %s got error: %v", fixed.HardwareModel, err)
+	}
+	if got.MaxLinecards != 0 || got.MaxControllerCards != 1 || got.MaxFabrics != 0 {
+		t.Errorf("Capabilities() of %s got %d linecards, %d controller cards, and %d fabrics, want 0, 1, and 0", fixed.HardwareModel, got.MaxLinecards, got.MaxControllerCards, got.MaxFabrics)
+	}
+}
+
+func TestIsFixedFormFactor(t *testing.T) {
+	tests := []struct {
+		hardwareModel string
+		want          bool
+	}{
+		{"8201-32FH", true},
+		{"8808", false},
+		{"8201-SYS", true},
+		{"Cisco 8201-32FH", true},
+		{"8808-SYS", false},
+		{"", false},
+		{"unknown", false},
+	}
+	for _, test := range tests {
+		n := &Namer{HardwareModel: test.hardwareModel}
+		if got := n.IsFixedFormFactor(); got != test.want {
+			t.Errorf("IsFixedFormFactor() with hardware model %q got %v, want %v", test.hardwareModel, got, test.want)
+		}
+	}
+}
+
+func TestUnlistedHardwareModel(t *testing.T) {
+	for _, hwm := range []string{"", "8201-32FH", "8201-SYS", "Cisco 8201-32FH", "8808"} {
+		n := &Namer{HardwareModel: hwm}
+		if _, err := n.Capabilities(); err != nil {
+			t.Errorf("Capabilities() with hardware model %q got error: %v", hwm, err)
+		}
+	}
+	for _, hwm := range []string{"ASR-9901", "NCS-5501", "8202-32FH-M", "8812"} {
+		n := &Namer{HardwareModel: hwm}
+		if _, err := n.LoopbackInterface(0); err != nil {
+			t.Errorf("LoopbackInterface(0) with hardware model %q got error: %v", hwm, err)
+		}
+		if _, err := n.AggregateInterface(0); err != nil {
+			t.Errorf("AggregateInterface(0) with hardware model %q got error: %v", hwm, err)
+		}
+		if _, err := n.Capabilities(); !errors.Is(err, namer.ErrUnsupportedHardwareModel) {
+			t.Errorf("Capabilities() with hardware model %q got error %v, want %v", hwm, err, namer.ErrUnsupportedHardwareModel)
+		}
+	}
+}
+
+func TestConformance(t *testing.T) {
+	namertest.TestNamer(t, cn)
+	t.Run("fixed form factor", func(t *testing.T) {
+		namertest.TestNamer(t, &Namer{HardwareModel: "8201-32FH"})
+	})
 }
@@ -17,33 +17,213 @@ package juniper
 
 import (
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
 	"strings"
 
-	"github.com/openconfig/entity-naming/internal/namer"
+	"github.com/openconfig/entity-naming/internal/namerutil"
+	"github.com/openconfig/entity-naming/namer"
+	"github.com/openconfig/entity-naming/oc"
 )
 
-var _ namer.Namer = (*Namer)(nil)
+var _ namerutil.Namer = (*Namer)(nil)
+
+const (
+	maxLoopbackIndex          = 0
+	maxAggregateIndex         = 1151
+	maxChassisIndex           = 0
+	maxIntegratedCircuitIndex = 7
+	maxCPUIndex               = 0
+	maxOpticalChannelIndex    = 0
+	maxSubinterfaceIndex      = 16384
+	minVLANID                 = 1
+	maxVLANID                 = 4094
+	maxManagementIndex        = 1
+	// maxLogicalChannelPIC is the largest PIC index that the logical channel
+	// index of a port on a fixed form factor device can hold.
+	maxLogicalChannelPIC = 7
+)
+
+// backplaneName is the name of the backplane of the only chassis.
+const backplaneName = "Midplane"
+
+var portSpeeds = []oc.E_IfEthernet_ETHERNET_SPEED{
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_50GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_200GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_800GB,
+}
+
+// mx204MediaPrefixes are the media prefixes of the ports of each speed of the
+// MX204, whose SFP+ ports run at 1G or 10G and whose QSFP28 ports run at 40G
+// or 100G or break out into 10G channels. It has no 25G ports.
+var mx204MediaPrefixes = map[oc.E_IfEthernet_ETHERNET_SPEED]string{
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB:   "ge",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB:  "xe",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB:  "et",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB: "et",
+}
+
+// sfp28MediaPrefixes are the media prefixes of the ports of each speed of the
+// QFX5120 and ACX5448, whose SFP28 ports run at 1G, 10G, or 25G, and whose
+// QSFP28 ports run at 40G or 100G or break out into 10G or 25G channels.
+var sfp28MediaPrefixes = map[oc.E_IfEthernet_ETHERNET_SPEED]string{
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB:   "ge",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB:  "xe",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB:  "et",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB:  "et",
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB: "et",
+}
+
+// mx204ChannelSpeeds are the speeds of the channels of the MX204 ports of
+// each speed whose breakout mode is not known: its QSFP28 ports break out
+// only into 10G channels.
+var mx204ChannelSpeeds = map[oc.E_IfEthernet_ETHERNET_SPEED]oc.E_IfEthernet_ETHERNET_SPEED{
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB:  oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB: oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB,
+}
+
+// sfp28ChannelSpeeds are the speeds of the channels of the QFX5120 and
+// ACX5448 ports of each speed whose breakout mode is not known: 40G ports
+// break out into 10G channels, and 100G ports into 25G channels.
+var sfp28ChannelSpeeds = map[oc.E_IfEthernet_ETHERNET_SPEED]oc.E_IfEthernet_ETHERNET_SPEED{
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB:  oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB: oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB,
+}
+
+// logicalChannelBases offset the Juniper logical channel indices of each kind
+// by a multiple of 100000, which leaves room below the next kind for the
+// packed indices of up to 100 FPC slots.
+var logicalChannelBases = map[namer.LogicalChannelKind]uint32{
+	namer.LogicalChannelEthernet: 100000,
+	namer.LogicalChannelOTN:      200000,
+	namer.LogicalChannelCoherent: 300000,
+}
+
+// hardwareModel describes a family of Juniper hardware models.
+type hardwareModel struct {
+	namerutil.HardwareModel
+	// mediaPrefixes are the media prefixes of the names of the ports of each
+	// speed that the hardware models support, if they run Junos OS, which
+	// names ports by speed. It is nil for hardware models running Junos OS
+	// Evolved, which name ports of every speed "et".
+	mediaPrefixes map[oc.E_IfEthernet_ETHERNET_SPEED]string
+	// channelSpeeds are the speeds of the channels of the ports of each speed
+	// whose breakout mode is not known, on hardware models running Junos OS.
+	channelSpeeds map[oc.E_IfEthernet_ETHERNET_SPEED]oc.E_IfEthernet_ETHERNET_SPEED
+	// managementPrefix is the prefix of the names of the management
+	// interfaces of hardware models running Junos OS, if it is not "em".
+	managementPrefix string
+}
+
+// portSpeeds returns the ethernet link speeds of the ports and channels of
+// the hardware models, from slowest to fastest.
+func (hwm *hardwareModel) portSpeeds() []oc.E_IfEthernet_ETHERNET_SPEED {
+	if hwm.mediaPrefixes == nil {
+		return portSpeeds
+	}
+	return slices.DeleteFunc(slices.Clone(portSpeeds), func(speed oc.E_IfEthernet_ETHERNET_SPEED) bool {
+		_, ok := hwm.mediaPrefixes[speed]
+		return !ok
+	})
+}
+
+// channelSpeed returns the speed of the channel of the port, which for a
+// channel whose breakout mode is not known is the speed of the channels that
+// ports of its speed break out into.
+func (hwm *hardwareModel) channelSpeed(pp *namer.PortParams) oc.E_IfEthernet_ETHERNET_SPEED {
+	if pp.ChannelIndex != nil && (pp.Breakout == nil || pp.Breakout.ChannelSpeed == oc.IfEthernet_ETHERNET_SPEED_UNSET) {
+		if speed, ok := hwm.channelSpeeds[pp.Speed]; ok {
+			return speed
+		}
+	}
+	return pp.ChannelSpeed()
+}
+
+// prefixSpeeds returns the speeds of the port, and of the channel if the name
+// has one, of a port name with the media prefix, and whether the hardware
+// models name any ports with the prefix. The speeds are unset on hardware
+// models running Junos OS Evolved. Where the prefix names several speeds, the
+// fastest channel speed that ports break out into is chosen, or else the
+// fastest speed, and the port speed of a channel is the fastest port speed
+// that breaks out into it, or else the speed of the channel.
+func (hwm *hardwareModel) prefixSpeeds(prefix string, channelized bool) (port, channel oc.E_IfEthernet_ETHERNET_SPEED, ok bool) {
+	if hwm.mediaPrefixes == nil {
+		return oc.IfEthernet_ETHERNET_SPEED_UNSET, oc.IfEthernet_ETHERNET_SPEED_UNSET, prefix == "et"
+	}
+	breakoutSpeeds := slices.Collect(maps.Values(hwm.channelSpeeds))
+	for _, speed := range hwm.portSpeeds() {
+		if hwm.mediaPrefixes[speed] != prefix {
+			continue
+		}
+		if !channelized {
+			port = speed
+		} else if slices.Contains(breakoutSpeeds, speed) || !slices.Contains(breakoutSpeeds, channel) {
+			channel = speed
+		}
+		ok = true
+	}
+	if !channelized || !ok {
+		return port, channel, ok
+	}
+	port = channel
+	for _, speed := range hwm.portSpeeds() {
+		if hwm.channelSpeeds[speed] == channel {
+			port = speed
+		}
+	}
+	return port, channel, true
+}
+
+// hardwareModels are the known Juniper hardware model families. The chassis
+// of the PTX10000 line are reported in inventories by their JNP part numbers,
+// such as JNP10001-36MR, as well as by their PTX model names.
+var hardwareModels = []hardwareModel{
+	{HardwareModel: namerutil.HardwareModel{Prefixes: []string{"PTX10001", "JNP10001"}, FixedFormFactor: true, NumLinecards: 1, NumControllerCards: 1, NumFabrics: 0, NumPowerSupplies: 2, NumFanTrays: 5, NumFansPerTray: 1, PortsPerASIC: 36}},
+	{HardwareModel: namerutil.HardwareModel{Prefixes: []string{"PTX10008", "JNP10008"}, NumLinecards: 8, NumControllerCards: 2, NumFabrics: 6, NumPowerSupplies: 6, NumFanTrays: 2, NumFansPerTray: 5, PortsPerASIC: 18}},
+	{HardwareModel: namerutil.HardwareModel{Prefixes: []string{"MX204"}, FixedFormFactor: true, NumLinecards: 1, NumControllerCards: 1, NumFabrics: 0, NumPowerSupplies: 2, NumFanTrays: 3, NumFansPerTray: 1, PortsPerASIC: 12}, mediaPrefixes: mx204MediaPrefixes, channelSpeeds: mx204ChannelSpeeds, managementPrefix: "fxp"},
+	{HardwareModel: namerutil.HardwareModel{Prefixes: []string{"QFX5120"}, FixedFormFactor: true, NumLinecards: 1, NumControllerCards: 1, NumFabrics: 0, NumPowerSupplies: 2, NumFanTrays: 5, NumFansPerTray: 1, PortsPerASIC: 56}, mediaPrefixes: sfp28MediaPrefixes, channelSpeeds: sfp28ChannelSpeeds},
+	{HardwareModel: namerutil.HardwareModel{Prefixes: []string{"ACX5448"}, FixedFormFactor: true, NumLinecards: 1, NumControllerCards: 1, NumFabrics: 0, NumPowerSupplies: 2, NumFanTrays: 5, NumFansPerTray: 1, PortsPerASIC: 52}, mediaPrefixes: sfp28MediaPrefixes, channelSpeeds: sfp28ChannelSpeeds},
+}
+
+// defaultHardwareModel describes the device if its hardware model is not
+// given: a PTX10008 running Junos OS Evolved.
+var defaultHardwareModel = hardwareModels[1]
 
 // Namer is a Juniper implementation of the Namer interface.
 type Namer struct {
 	HardwareModel string
 }
 
+// family returns the Juniper family of the hardware model of the device, or
+// the default family if the hardware model is not listed, so that names that
+// do not depend on the hardware model are available for every device.
+// Case and the choice of separators in the hardware model are ignored.
+func (n *Namer) family() *hardwareModel {
+	hwm, _ := namerutil.FindHardwareModel(hardwareModels, &defaultHardwareModel, n.HardwareModel)
+	return hwm
+}
+
 // LoopbackInterface is an implementation of namer.LoopbackInterface.
 func (n *Namer) LoopbackInterface(index uint) (string, error) {
-	if index != 0 {
+	if index > maxLoopbackIndex {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Juniper only supports loopback interface zero")
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindLoopback, Index: int(index), Max: maxLoopbackIndex})
 	}
 	return "lo0", nil
 }
 
 // AggregateInterface is an implementation of namer.AggregateInterface.
 func (n *Namer) AggregateInterface(index uint) (string, error) {
-	const maxIndex = 1151
-	if index > maxIndex {
+	if index > maxAggregateIndex {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Juniper aggregate index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindAggregate, Index: int(index), Max: maxAggregateIndex})
 	}
 	return fmt.Sprintf("ae%d", index), nil
 }
@@ -57,45 +237,207 @@ func (n *Namer) AggregateMemberInterface(index uint) (string, error) {
 	return name + ".0", nil
 }
 
+// Subinterface is an implementation of namer.Subinterface.
+func (n *Namer) Subinterface(parent string, index uint) (string, error) {
+	if index > maxSubinterfaceIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindSubinterface, Index: int(index), Max: maxSubinterfaceIndex})
+	}
+	return fmt.Sprintf("%s.%d", parent, index), nil
+}
+
+// VLANInterface is an implementation of namer.VLANInterface.
+func (n *Namer) VLANInterface(vlanID uint) (string, error) {
+	if vlanID < minVLANID || vlanID > maxVLANID {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindVLANInterface, Index: int(vlanID), Max: maxVLANID})
+	}
+	return n.Subinterface("irb", vlanID)
+}
+
+// TunnelInterface is an implementation of namer.TunnelInterface.
+// Juniper tunnels are units of the tunnel service interface of the linecard
+// hosting the tunnel service.
+func (n *Namer) TunnelInterface(tp *namer.TunnelParams) (string, error) {
+	var prefix string
+	switch tp.Kind {
+	case namer.TunnelGRE:
+		prefix = "gr"
+	case namer.TunnelIPinIP:
+		prefix = "ip"
+	default:
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper does not support %s tunnel interfaces: %w", tp.Kind, namer.ErrUnsupportedEntity)
+	}
+	if _, err := n.Linecard(tp.LinecardIndex); err != nil {
+		return "", err
+	}
+	return n.Subinterface(fmt.Sprintf("%s-%d/0/0", prefix, tp.LinecardIndex), tp.Index)
+}
+
+// ManagementInterface is an implementation of namer.ManagementInterface.
+// Junos OS Evolved names management interfaces by routing engine, while
+// Junos OS names them "em" or, on some hardware models, "fxp".
+func (n *Namer) ManagementInterface(controllerCardIndex, index uint) (string, error) {
+	if _, err := n.ControllerCard(controllerCardIndex); err != nil {
+		return "", err
+	}
+	if index > maxManagementIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindManagementInterface, Index: int(index), Max: maxManagementIndex})
+	}
+	hwm := n.family()
+	if hwm.mediaPrefixes == nil {
+		return fmt.Sprintf("re%d:mgmt-%d", controllerCardIndex, index), nil
+	}
+	if hwm.managementPrefix != "" {
+		return fmt.Sprintf("%s%d", hwm.managementPrefix, index), nil
+	}
+	return fmt.Sprintf("em%d", index), nil
+}
+
 // Linecard is an implementation of namer.Linecard.
 func (n *Namer) Linecard(index uint) (string, error) {
-	const maxIndex = 7
-	if index > maxIndex {
+	hwm := n.family()
+	if index >= hwm.NumLinecards {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Juniper linecard index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindLinecard, Index: int(index), Max: int(hwm.NumLinecards) - 1})
 	}
 	return fmt.Sprintf("FPC%d", index), nil
 }
 
 // ControllerCard is an implementation of namer.ControllerCard.
 func (n *Namer) ControllerCard(index uint) (string, error) {
-	const maxIndex = 1
-	if index > maxIndex {
+	hwm := n.family()
+	if index >= hwm.NumControllerCards {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Juniper controller card index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindControllerCard, Index: int(index), Max: int(hwm.NumControllerCards) - 1})
 	}
 	return fmt.Sprintf("RE%d", index), nil
 }
 
 // Fabric is an implementation of namer.Fabric.
 func (n *Namer) Fabric(index uint) (string, error) {
-	const maxIndex = 5
-	if index > maxIndex {
+	hwm := n.family()
+	if hwm.NumFabrics == 0 {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Juniper fabric index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Juniper fixed form factor routers have no fabrics: %w", namer.ErrUnsupportedEntity)
+	}
+	if index >= hwm.NumFabrics {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindFabric, Index: int(index), Max: int(hwm.NumFabrics) - 1})
 	}
 	return fmt.Sprintf("SIB%d", index), nil
 }
 
+// PowerSupply is an implementation of namer.PowerSupply.
+func (n *Namer) PowerSupply(index uint) (string, error) {
+	hwm := n.family()
+	if index >= hwm.NumPowerSupplies {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindPowerSupply, Index: int(index), Max: int(hwm.NumPowerSupplies) - 1})
+	}
+	return fmt.Sprintf("PSM %d", index), nil
+}
+
+// FanTray is an implementation of namer.FanTray.
+func (n *Namer) FanTray(index uint) (string, error) {
+	hwm := n.family()
+	if index >= hwm.NumFanTrays {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindFanTray, Index: int(index), Max: int(hwm.NumFanTrays) - 1})
+	}
+	return fmt.Sprintf("Fan Tray %d", index), nil
+}
+
+// Fan is an implementation of namer.Fan.
+func (n *Namer) Fan(trayIndex, fanIndex uint) (string, error) {
+	if _, err := n.FanTray(trayIndex); err != nil {
+		return "", err
+	}
+	hwm := n.family()
+	if fanIndex >= hwm.NumFansPerTray {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindFan, Index: int(fanIndex), Max: int(hwm.NumFansPerTray) - 1})
+	}
+	return fmt.Sprintf("Fan Tray %d Fan %d", trayIndex, fanIndex), nil
+}
+
+// IntegratedCircuit is an implementation of namer.IntegratedCircuit.
+func (n *Namer) IntegratedCircuit(linecardIndex, chipIndex uint) (string, error) {
+	lc, err := n.Linecard(linecardIndex)
+	if err != nil {
+		return "", err
+	}
+	if chipIndex > maxIntegratedCircuitIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindIntegratedCircuit, Index: int(chipIndex), Max: maxIntegratedCircuitIndex})
+	}
+	return fmt.Sprintf("%s:PIC0:PE%d", lc, chipIndex), nil
+}
+
+// CPU is an implementation of namer.CPU.
+func (n *Namer) CPU(cardKind namer.EntityKind, cardIndex, cpuIndex uint) (string, error) {
+	card, err := namerutil.Card(n, cardKind, cardIndex)
+	if err != nil {
+		return "", err
+	}
+	if cpuIndex > maxCPUIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindCPU, Index: int(cpuIndex), Max: maxCPUIndex})
+	}
+	return fmt.Sprintf("%s:CPU%d", card, cpuIndex), nil
+}
+
+// Chassis is an implementation of namer.Chassis.
+func (n *Namer) Chassis(index uint) (string, error) {
+	if index > maxChassisIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindChassis, Index: int(index), Max: maxChassisIndex})
+	}
+	return fmt.Sprintf("CHASSIS%d", index), nil
+}
+
+// Backplane is an implementation of namer.Backplane.
+func (n *Namer) Backplane(chassisIndex uint) (string, error) {
+	if _, err := n.Chassis(chassisIndex); err != nil {
+		return "", err
+	}
+	return backplaneName, nil
+}
+
+// mediaPrefix returns the media prefix of the names of ports of the speed.
+func (n *Namer) mediaPrefix(speed oc.E_IfEthernet_ETHERNET_SPEED) (string, error) {
+	hwm := n.family()
+	if hwm.mediaPrefixes == nil {
+		return "et", nil
+	}
+	prefix, ok := hwm.mediaPrefixes[speed]
+	if !ok {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper %w", &namer.UnsupportedSpeedError{Speed: speed, HardwareModel: n.HardwareModel})
+	}
+	return prefix, nil
+}
+
 // Port is an implementation of namer.Port.
+// Juniper names each channel of a port with the media prefix of the speed of
+// the channel, and names unchannelizable ports like unchannelized ones. On
+// hardware models running Junos OS, the speed of a channel whose breakout mode
+// is not known is that of the channels its port breaks out into by default.
 func (n *Namer) Port(pp *namer.PortParams) (string, error) {
-	if !pp.Channelizable {
+	hwm := n.family()
+	if _, ok := hwm.mediaPrefixes[pp.Speed]; hwm.mediaPrefixes != nil && !ok {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Juniper does not support unchannelizable ports")
+		return "", fmt.Errorf("Juniper %w", &namer.UnsupportedSpeedError{Speed: pp.Speed, HardwareModel: n.HardwareModel})
+	}
+	prefix, err := n.mediaPrefix(hwm.channelSpeed(pp))
+	if err != nil {
+		return "", err
 	}
 
 	var nameBuilder strings.Builder
-	nameBuilder.WriteString("et-")
+	nameBuilder.WriteString(prefix + "-")
 	if pp.SlotIndex == nil {
 		nameBuilder.WriteString("0/")
 		nameBuilder.WriteString(fmt.Sprintf("%d", pp.PICIndex))
@@ -110,10 +452,176 @@ func (n *Namer) Port(pp *namer.PortParams) (string, error) {
 	return nameBuilder.String(), nil
 }
 
+var portIndicesRE = regexp.MustCompile(`^(\d+)/(\d+)/(\d+)(?::(\d+))?$`)
+
+// ParsePort is an implementation of namer.ParsePort.
+// Juniper does not distinguish unchannelized and unchannelizable port names,
+// so a port without a channel is parsed as channelizable. On hardware models
+// running Junos OS, the media prefix encodes the speed of the port, or of the
+// channel if the name has one, and the speeds chosen for a prefix that names
+// several are those of prefixSpeeds.
+func (n *Namer) ParsePort(name string) (*namer.PortParams, error) {
+	prefix, indicesName, ok := strings.Cut(name, "-")
+	if !ok {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return nil, fmt.Errorf("Juniper port name %q is invalid", name)
+	}
+	indices, ok := namerutil.MatchIndices(portIndicesRE, indicesName)
+	if !ok {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return nil, fmt.Errorf("Juniper port name %q is invalid", name)
+	}
+	portSpeed, channelSpeed, ok := n.family().prefixSpeeds(prefix, indices[3] != nil)
+	if !ok {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return nil, fmt.Errorf("Juniper port name %q has an unknown media prefix", name)
+	}
+	pp := &namer.PortParams{
+		PortIndex:     *indices[2],
+		ChannelIndex:  indices[3],
+		Channelizable: true,
+		Speed:         portSpeed,
+	}
+	if channelSpeed != oc.IfEthernet_ETHERNET_SPEED_UNSET {
+		pp.Breakout = &namer.BreakoutMode{ChannelSpeed: channelSpeed}
+	}
+	if n.IsFixedFormFactor() {
+		if *indices[0] != 0 {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return nil, fmt.Errorf("Juniper port name %q has a non-zero FPC on a fixed form factor device", name)
+		}
+		pp.PICIndex = *indices[1]
+	} else {
+		if *indices[1] != 0 {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return nil, fmt.Errorf("Juniper port name %q has a non-zero PIC on a modular device", name)
+		}
+		pp.SlotIndex = indices[0]
+	}
+	return pp, nil
+}
+
+// Transceiver is an implementation of namer.Transceiver.
+func (n *Namer) Transceiver(pp *namer.PortParams) (string, error) {
+	fpc, pic := uint(0), pp.PICIndex
+	if pp.SlotIndex != nil {
+		fpc, pic = *pp.SlotIndex, 0
+	}
+	return fmt.Sprintf("FPC%d:PIC%d:PORT%d:Xcvr0", fpc, pic, pp.PortIndex), nil
+}
+
+// OpticalChannel is an implementation of namer.OpticalChannel.
+func (n *Namer) OpticalChannel(pp *namer.PortParams, index uint) (string, error) {
+	if index > maxOpticalChannelIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindOpticalChannel, Index: int(index), Max: maxOpticalChannelIndex})
+	}
+	fpc, pic := uint(0), pp.PICIndex
+	if pp.SlotIndex != nil {
+		fpc, pic = *pp.SlotIndex, 0
+	}
+	return fmt.Sprintf("FPC%d:PIC%d:PORT%d:OpticalChannel%d", fpc, pic, pp.PortIndex, index), nil
+}
+
+// LogicalChannelIndex is an implementation of namer.LogicalChannelIndex.
+// The index packs the FPC, or the PIC on a fixed form factor device, and the
+// port and channel of the port into its digits.
+func (n *Namer) LogicalChannelIndex(pp *namer.PortParams, kind namer.LogicalChannelKind) (uint32, error) {
+	base, ok := logicalChannelBases[kind]
+	if !ok {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return 0, fmt.Errorf("Juniper does not support %s logical channels: %w", kind, namer.ErrUnsupportedEntity)
+	}
+	slot := pp.PICIndex
+	if pp.SlotIndex != nil {
+		slot = *pp.SlotIndex
+		if numLinecards := n.family().NumLinecards; slot >= numLinecards {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return 0, fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindLinecard, Index: int(slot), Max: int(numLinecards) - 1})
+		}
+	} else if slot > maxLogicalChannelPIC {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return 0, fmt.Errorf("Juniper %w", &namer.IndexOutOfRangeError{Entity: namer.KindPIC, Index: int(slot), Max: maxLogicalChannelPIC})
+	}
+	return namerutil.PackLogicalChannelIndex(base, slot, pp)
+}
+
+// PortASIC is an implementation of namer.PortASIC.
+func (n *Namer) PortASIC(pp *namer.PortParams) (string, error) {
+	var linecardIndex uint
+	if pp.SlotIndex != nil {
+		linecardIndex = *pp.SlotIndex
+	}
+	return n.IntegratedCircuit(linecardIndex, pp.PortIndex/n.family().PortsPerASIC)
+}
+
+var (
+	loopbackRE        = regexp.MustCompile(`^lo(\d+)$`)
+	aggregateRE       = regexp.MustCompile(`^ae(\d+)$`)
+	aggregateMemberRE = regexp.MustCompile(`^ae(\d+)\.0$`)
+	linecardRE        = regexp.MustCompile(`^FPC(\d+)$`)
+	controllerCardRE  = regexp.MustCompile(`^RE(\d+)$`)
+	fabricRE          = regexp.MustCompile(`^SIB(\d+)$`)
+	vlanInterfaceRE   = regexp.MustCompile(`^irb\.(\d+)$`)
+	powerSupplyRE     = regexp.MustCompile(`^PSM (\d+)$`)
+	fanTrayRE         = regexp.MustCompile(`^Fan Tray (\d+)$`)
+	chassisRE         = regexp.MustCompile(`^CHASSIS(\d+)$`)
+)
+
+// ParseIndex is an implementation of namer.ParseIndex.
+func (n *Namer) ParseIndex(kind namer.EntityKind, name string) (uint, error) {
+	switch kind {
+	case namer.KindLoopback:
+		return namerutil.InvertIndex(name, loopbackRE, 0, n.LoopbackInterface)
+	case namer.KindAggregate:
+		return namerutil.InvertIndex(name, aggregateRE, 0, n.AggregateInterface)
+	case namer.KindAggregateMember:
+		return namerutil.InvertIndex(name, aggregateMemberRE, 0, n.AggregateMemberInterface)
+	case namer.KindLinecard:
+		return namerutil.InvertIndex(name, linecardRE, 0, n.Linecard)
+	case namer.KindControllerCard:
+		return namerutil.InvertIndex(name, controllerCardRE, 0, n.ControllerCard)
+	case namer.KindFabric:
+		return namerutil.InvertIndex(name, fabricRE, 0, n.Fabric)
+	case namer.KindVLANInterface:
+		return namerutil.InvertIndex(name, vlanInterfaceRE, 0, n.VLANInterface)
+	case namer.KindPowerSupply:
+		return namerutil.InvertIndex(name, powerSupplyRE, 0, n.PowerSupply)
+	case namer.KindFanTray:
+		return namerutil.InvertIndex(name, fanTrayRE, 0, n.FanTray)
+	case namer.KindChassis:
+		return namerutil.InvertIndex(name, chassisRE, 0, n.Chassis)
+	case namer.KindBackplane:
+		return namerutil.InvertSingleton(name, backplaneName)
+	}
+	//nolint:staticcheck // ST1005 string begins with proper noun
+	return 0, fmt.Errorf("Juniper cannot parse the index of a %s: %w", kind, namer.ErrUnsupportedEntity)
+}
+
 // IsFixedFormFactor is an implementation of namer.IsFixedFormFactor.
+// Without a hardware model, the device is assumed to be a PTX10008.
 func (n *Namer) IsFixedFormFactor() bool {
-	// TODO(juniper): Fill in this implementation.
-	return false
+	return n.family().FixedFormFactor
+}
+
+// Capabilities is an implementation of namer.Capabilities.
+// The limits of hardware models that are not listed are not known.
+func (n *Namer) Capabilities() (*namer.Capabilities, error) {
+	hwm, err := namerutil.FindHardwareModel(hardwareModels, &defaultHardwareModel, n.HardwareModel)
+	if err != nil {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return nil, fmt.Errorf("Juniper %w", err)
+	}
+	return &namer.Capabilities{
+		MaxLoopbacks:       maxLoopbackIndex + 1,
+		MaxAggregates:      maxAggregateIndex + 1,
+		MaxLinecards:       hwm.NumLinecards,
+		MaxControllerCards: hwm.NumControllerCards,
+		MaxFabrics:         hwm.NumFabrics,
+		MaxPowerSupplies:   hwm.NumPowerSupplies,
+		MaxFanTrays:        hwm.NumFanTrays,
+		PortSpeeds:         hwm.portSpeeds(),
+	}, nil
 }
 
 // CommonQoSQueues is an implementation of namer.CommonQoSQueues.
@@ -15,10 +15,16 @@
 package juniper
 
 import (
+	"errors"
+	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
-	"github.com/openconfig/entity-naming/internal/namer"
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/entity-naming/namer"
+	"github.com/openconfig/entity-naming/namer/namertest"
+	"github.com/openconfig/entity-naming/oc"
 )
 
 var jn = new(Namer)
@@ -36,9 +42,13 @@ func TestLoopbackInterface(t *testing.T) {
 
 	t.Run("nonzero", func(t *testing.T) {
 		_, err := jn.LoopbackInterface(1)
-		if wantErr := "zero"; err == nil || !strings.Contains(err.Error(), wantErr) {
+		if wantErr := "exceed"; err == nil || !strings.Contains(err.Error(), wantErr) {
 			t.Fatalf("LoopbackInterface(1) got error %v, want substring %q", err, wantErr)
 		}
+		var rangeErr *namer.IndexOutOfRangeError
+		if !errors.As(err, &rangeErr) || rangeErr.Entity != namer.KindLoopback || rangeErr.Max != maxLoopbackIndex {
+			t.Errorf("LoopbackInterface(1) got error %v, want an IndexOutOfRangeError with max %d", err, maxLoopbackIndex)
+		}
 	})
 }
 
@@ -110,6 +120,113 @@ func TestAggregateMemberInterface(t *testing.T) {
 	})
 }
 
+func TestSubinterface(t *testing.T) {
+	tests := []struct {
+		desc  string
+		index uint
+		want  string
+	}{{
+		desc:  "min",
+		index: 0,
+		want:  "et-0/0/1.0",
+	}, {
+		desc:  "max",
+		index: 16384,
+		want:  "et-0/0/1.16384",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := jn.Subinterface("et-0/0/1", test.index)
+			if err != nil {
+				t.Fatalf("Subinterface(%q,%d) got error: %v", "et-0/0/1", test.index, err)
+			}
+			if got != test.want {
+				t.Errorf("Subinterface(%q,%d) got %q, want %q", "et-0/0/1", test.index, got, test.want)
+			}
+		})
+	}
+
+	t.Run("over max", func(t *testing.T) {
+		_, err := jn.Subinterface("et-0/0/1", 16385)
+		if wantErr := "exceed"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("Subinterface(%q,16385) got error %v, want substring %q", "et-0/0/1", err, wantErr)
+		}
+	})
+}
+
+func TestVLANInterface(t *testing.T) {
+	tests := []struct {
+		desc   string
+		vlanID uint
+		want   string
+	}{{
+		desc:   "min",
+		vlanID: 1,
+		want:   "irb.1",
+	}, {
+		desc:   "max",
+		vlanID: 4094,
+		want:   "irb.4094",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := jn.VLANInterface(test.vlanID)
+			if err != nil {
+				t.Fatalf("VLANInterface(%d) got error: %v", test.vlanID, err)
+			}
+			if got != test.want {
+				t.Errorf("VLANInterface(%d) got %q, want %q", test.vlanID, got, test.want)
+			}
+		})
+	}
+
+	for _, vlanID := range []uint{0, 4095} {
+		t.Run(fmt.Sprintf("invalid %d", vlanID), func(t *testing.T) {
+			_, err := jn.VLANInterface(vlanID)
+			var rangeErr *namer.IndexOutOfRangeError
+			if !errors.As(err, &rangeErr) || rangeErr.Entity != namer.KindVLANInterface {
+				t.Errorf("VLANInterface(%d) got error %v, want an IndexOutOfRangeError", vlanID, err)
+			}
+		})
+	}
+}
+
+func TestTunnelInterface(t *testing.T) {
+	tests := []struct {
+		desc    string
+		tp      *namer.TunnelParams
+		want    string
+		wantErr bool
+	}{{
+		desc: "GRE",
+		tp:   &namer.TunnelParams{Kind: namer.TunnelGRE, Index: 0},
+		want: "gr-0/0/0.0",
+	}, {
+		desc: "IP-in-IP on linecard",
+		tp:   &namer.TunnelParams{Kind: namer.TunnelIPinIP, LinecardIndex: 2, Index: 5},
+		want: "ip-2/0/0.5",
+	}, {
+		desc:    "invalid linecard",
+		tp:      &namer.TunnelParams{Kind: namer.TunnelGRE, LinecardIndex: 8, Index: 0},
+		wantErr: true,
+	}, {
+		desc:    "MPLS-TE",
+		tp:      &namer.TunnelParams{Kind: namer.TunnelMPLSTE, Index: 0},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := jn.TunnelInterface(test.tp)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("TunnelInterface(%v) got error %v, want error %v", test.tp, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("TunnelInterface(%v) got %q, want %q", test.tp, got, test.want)
+			}
+		})
+	}
+}
+
 func TestPort(t *testing.T) {
 	uintPtr := func(i uint) *uint { return &i }
 
@@ -118,6 +235,14 @@ func TestPort(t *testing.T) {
 		pp   *namer.PortParams
 		want string
 	}{{
+		desc: "unchannelizable",
+		pp: &namer.PortParams{
+			SlotIndex: uintPtr(1),
+			PICIndex:  2,
+			PortIndex: 3,
+		},
+		want: "et-1/0/3",
+	}, {
 		desc: "channelizable",
 		pp: &namer.PortParams{
 			SlotIndex:     uintPtr(1),
@@ -165,20 +290,451 @@ func TestPort(t *testing.T) {
 			}
 		})
 	}
+}
 
-	t.Run("unchannelizable", func(t *testing.T) {
+func TestPortMediaPrefix(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc          string
+		hardwareModel string
+		pp            *namer.PortParams
+		want          string
+	}{{
+		desc:          "gigabit on Junos OS",
+		hardwareModel: "MX204",
+		pp:            &namer.PortParams{PortIndex: 3, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB},
+		want:          "ge-0/0/3",
+	}, {
+		desc:          "ten gigabit on Junos OS",
+		hardwareModel: "QFX5120-48Y",
+		pp:            &namer.PortParams{PortIndex: 3, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB},
+		want:          "xe-0/0/3",
+	}, {
+		desc:          "twenty five gigabit on Junos OS",
+		hardwareModel: "QFX5120-48Y",
+		pp:            &namer.PortParams{PortIndex: 3, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB},
+		want:          "et-0/0/3",
+	}, {
+		desc:          "ten gigabit channel on Junos OS",
+		hardwareModel: "ACX5448",
+		pp: &namer.PortParams{
+			PortIndex:     49,
+			ChannelIndex:  uintPtr(2),
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB,
+			Breakout: &namer.BreakoutMode{
+				NumChannels:  4,
+				ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB,
+			},
+		},
+		want: "xe-0/0/49:2",
+	}, {
+		desc:          "channel without breakout mode on Junos OS",
+		hardwareModel: "MX204",
+		pp:            &namer.PortParams{PortIndex: 3, ChannelIndex: uintPtr(1), Channelizable: true, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+		want:          "xe-0/0/3:1",
+	}, {
+		desc:          "twenty five gigabit channel without breakout mode on Junos OS",
+		hardwareModel: "QFX5120-48Y",
+		pp:            &namer.PortParams{PortIndex: 3, ChannelIndex: uintPtr(1), Channelizable: true, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+		want:          "et-0/0/3:1",
+	}, {
+		desc:          "ten gigabit channel without breakout mode on Junos OS",
+		hardwareModel: "QFX5120-48Y",
+		pp:            &namer.PortParams{PortIndex: 3, ChannelIndex: uintPtr(1), Channelizable: true, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB},
+		want:          "xe-0/0/3:1",
+	}, {
+		desc:          "ten gigabit on Junos OS Evolved",
+		hardwareModel: "PTX10001-36MR",
+		pp:            &namer.PortParams{PortIndex: 3, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB},
+		want:          "et-0/0/3",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			n := &Namer{HardwareModel: test.hardwareModel}
+			got, err := n.Port(test.pp)
+			if err != nil {
+				t.Fatalf("Port(%v) got error: %v", test.pp, err)
+			}
+			if got != test.want {
+				t.Errorf("Port(%v) got %q, want %q", test.pp, got, test.want)
+			}
+		})
+	}
+
+	t.Run("unsupported port speed with supported channel speed", func(t *testing.T) {
+		n := &Namer{HardwareModel: "MX204"}
 		pp := &namer.PortParams{
-			SlotIndex: uintPtr(1),
-			PICIndex:  2,
-			PortIndex: 3,
+			PortIndex:     3,
+			ChannelIndex:  uintPtr(0),
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+			Breakout:      &namer.BreakoutMode{NumChannels: 4, ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+		}
+		if _, err := n.Port(pp); !errors.Is(err, namer.ErrUnsupportedSpeed) {
+			t.Fatalf("Port(%v) got error %v, want %v", pp, err, namer.ErrUnsupportedSpeed)
 		}
-		if _, err := jn.Port(pp); err == nil || !strings.Contains(err.Error(), "unchannelizable") {
-			t.Fatalf("Port(%v) got unexpected error %v, want substring 'unchannelizable'", pp, err)
+	})
+
+	t.Run("unsupported speed", func(t *testing.T) {
+		n := &Namer{HardwareModel: "MX204"}
+		pp := &namer.PortParams{PortIndex: 3, Speed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100MB}
+		_, err := n.Port(pp)
+		var speedErr *namer.UnsupportedSpeedError
+		if !errors.As(err, &speedErr) || !errors.Is(err, namer.ErrUnsupportedSpeed) {
+			t.Fatalf("Port(%v) got error %v, want an UnsupportedSpeedError", pp, err)
 		}
+		if speedErr.HardwareModel != "MX204" {
+			t.Errorf("Port(%v) got error for hardware model %q, want %q", pp, speedErr.HardwareModel, "MX204")
+		}
+	})
+}
 
+func TestPortFamilySpeeds(t *testing.T) {
+	tests := []struct {
+		hardwareModel string
+		speed         oc.E_IfEthernet_ETHERNET_SPEED
+		want          string
+		wantErr       bool
+	}{
+		{"MX204", oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB, "ge-0/0/3", false},
+		{"MX204", oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB, "xe-0/0/3", false},
+		{"MX204", oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB, "", true},
+		{"MX204", oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB, "et-0/0/3", false},
+		{"QFX5120-48Y", oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB, "ge-0/0/3", false},
+		{"QFX5120-48Y", oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB, "xe-0/0/3", false},
+		{"QFX5120-48Y", oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB, "et-0/0/3", false},
+		{"QFX5120-48Y", oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB, "et-0/0/3", false},
+		{"ACX5448", oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB, "ge-0/0/3", false},
+		{"ACX5448", oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB, "xe-0/0/3", false},
+		{"ACX5448", oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB, "et-0/0/3", false},
+		{"ACX5448", oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB, "et-0/0/3", false},
+	}
+	for _, test := range tests {
+		n := &Namer{HardwareModel: test.hardwareModel}
+		pp := &namer.PortParams{PortIndex: 3, Speed: test.speed}
+		got, err := n.Port(pp)
+		if gotErr := errors.Is(err, namer.ErrUnsupportedSpeed); gotErr != test.wantErr {
+			t.Errorf("Port(%v) on %s got error %v, want unsupported speed %v", pp, test.hardwareModel, err, test.wantErr)
+		}
+		if got != test.want {
+			t.Errorf("Port(%v) on %s got %q, want %q", pp, test.hardwareModel, got, test.want)
+		}
+		caps, err := n.Capabilities()
+		if err != nil {
+			t.Fatalf("Capabilities() of %s got error: %v", test.hardwareModel, err)
+		}
+		if gotSpeed := slices.Contains(caps.PortSpeeds, test.speed); gotSpeed == test.wantErr {
+			t.Errorf("Capabilities() of %s got port speed %v %v, want %v", test.hardwareModel, test.speed, gotSpeed, !test.wantErr)
+		}
+	}
+}
+
+func TestParsePort(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc string
+		name string
+		want *namer.PortParams
+	}{{
+		desc: "channelizable",
+		name: "et-1/0/3",
+		want: &namer.PortParams{
+			SlotIndex:     uintPtr(1),
+			PortIndex:     3,
+			Channelizable: true,
+		},
+	}, {
+		desc: "channelized",
+		name: "et-1/0/3:4",
+		want: &namer.PortParams{
+			SlotIndex:     uintPtr(1),
+			PortIndex:     3,
+			ChannelIndex:  uintPtr(4),
+			Channelizable: true,
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := jn.ParsePort(test.name)
+			if err != nil {
+				t.Fatalf("ParsePort(%q) got error: %v", test.name, err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ParsePort(%q) got unexpected diff (-want +got):\n%s", test.name, diff)
+			}
+		})
+	}
+
+	for _, name := range []string{"et-1/2/3", "et-1/0", "xe-1/0/3", "ae0"} {
+		t.Run("invalid "+name, func(t *testing.T) {
+			if _, err := jn.ParsePort(name); err == nil || !strings.Contains(err.Error(), name) {
+				t.Fatalf("ParsePort(%q) got error %v, want substring %q", name, err, name)
+			}
+		})
+	}
+
+	mediaPrefixTests := []struct {
+		desc string
+		name string
+		want *namer.PortParams
+	}{{
+		desc: "gigabit",
+		name: "ge-0/0/3",
+		want: &namer.PortParams{
+			PortIndex:     3,
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB,
+		},
+	}, {
+		desc: "ten gigabit channel",
+		name: "xe-0/1/2:3",
+		want: &namer.PortParams{
+			PICIndex:      1,
+			PortIndex:     2,
+			ChannelIndex:  uintPtr(3),
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+			Breakout:      &namer.BreakoutMode{ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB},
+		},
+	}, {
+		desc: "forty gigabit or faster",
+		name: "et-0/0/3",
+		want: &namer.PortParams{
+			PortIndex:     3,
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+		},
+	}, {
+		desc: "forty gigabit or faster channel",
+		name: "et-0/0/3:1",
+		want: &namer.PortParams{
+			PortIndex:     3,
+			ChannelIndex:  uintPtr(1),
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+			Breakout:      &namer.BreakoutMode{ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+		},
+	}}
+	mx := &Namer{HardwareModel: "MX204"}
+	for _, test := range mediaPrefixTests {
+		t.Run("Junos OS "+test.desc, func(t *testing.T) {
+			got, err := mx.ParsePort(test.name)
+			if err != nil {
+				t.Fatalf("ParsePort(%q) got error: %v", test.name, err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ParsePort(%q) got unexpected diff (-want +got):\n%s", test.name, diff)
+			}
+		})
+	}
+	qfx := &Namer{HardwareModel: "QFX5120-48Y"}
+	t.Run("Junos OS twenty five gigabit channel", func(t *testing.T) {
+		const name = "et-0/0/3:1"
+		got, err := qfx.ParsePort(name)
+		if err != nil {
+			t.Fatalf("ParsePort(%q) got error: %v", name, err)
+		}
+		want := &namer.PortParams{
+			PortIndex:     3,
+			ChannelIndex:  uintPtr(1),
+			Channelizable: true,
+			Speed:         oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+			Breakout:      &namer.BreakoutMode{ChannelSpeed: oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("ParsePort(%q) got unexpected diff (-want +got):\n%s", name, diff)
+		}
+	})
+	for _, n := range []*Namer{mx, qfx} {
+		for _, name := range []string{"ge-0/0/3", "xe-0/0/3", "et-0/0/3", "xe-0/0/3:1", "et-0/0/3:1"} {
+			t.Run(fmt.Sprintf("%s round trip %s", n.HardwareModel, name), func(t *testing.T) {
+				pp, err := n.ParsePort(name)
+				if err != nil {
+					t.Fatalf("ParsePort(%q) got error: %v", name, err)
+				}
+				if got, err := n.Port(pp); err != nil || got != name {
+					t.Errorf("Port(ParsePort(%q)) got %q, %v, want %q", name, got, err, name)
+				}
+			})
+		}
+	}
+	t.Run("Junos OS invalid fe-0/0/3", func(t *testing.T) {
+		if _, err := mx.ParsePort("fe-0/0/3"); err == nil || !strings.Contains(err.Error(), "fe-0/0/3") {
+			t.Fatalf("ParsePort(%q) got error %v, want substring %q", "fe-0/0/3", err, "fe-0/0/3")
+		}
 	})
 }
 
+func TestTransceiver(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc string
+		pp   *namer.PortParams
+		want string
+	}{{
+		desc: "modular",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		want: "FPC1:PIC0:PORT2:Xcvr0",
+	}, {
+		desc: "channelized",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: "FPC1:PIC0:PORT2:Xcvr0",
+	}, {
+		desc: "fixed form factor",
+		pp:   &namer.PortParams{PICIndex: 1, PortIndex: 2},
+		want: "FPC0:PIC1:PORT2:Xcvr0",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := jn.Transceiver(test.pp)
+			if err != nil {
+				t.Fatalf("Transceiver(%v) got error: %v", test.pp, err)
+			}
+			if got != test.want {
+				t.Errorf("Transceiver(%v) got %q, want %q", test.pp, got, test.want)
+			}
+		})
+	}
+}
+
+func TestOpticalChannel(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc    string
+		pp      *namer.PortParams
+		index   uint
+		want    string
+		wantErr bool
+	}{{
+		desc: "modular",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		want: "FPC1:PIC0:PORT2:OpticalChannel0",
+	}, {
+		desc: "channelized",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: "FPC1:PIC0:PORT2:OpticalChannel0",
+	}, {
+		desc: "fixed form factor",
+		pp:   &namer.PortParams{PICIndex: 1, PortIndex: 2},
+		want: "FPC0:PIC1:PORT2:OpticalChannel0",
+	}, {
+		desc:    "index over max",
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		index:   maxOpticalChannelIndex + 1,
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := jn.OpticalChannel(test.pp, test.index)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("OpticalChannel(%v,%d) got error %v, want error %v", test.pp, test.index, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("OpticalChannel(%v,%d) got %q, want %q", test.pp, test.index, got, test.want)
+			}
+		})
+	}
+}
+
+func TestLogicalChannelIndex(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc    string
+		kind    namer.LogicalChannelKind
+		pp      *namer.PortParams
+		want    uint32
+		wantErr bool
+	}{{
+		desc: "ethernet",
+		kind: namer.LogicalChannelEthernet,
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		want: 101020,
+	}, {
+		desc: "OTN channel",
+		kind: namer.LogicalChannelOTN,
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: 201024,
+	}, {
+		desc: "coherent fixed form factor",
+		kind: namer.LogicalChannelCoherent,
+		pp:   &namer.PortParams{PICIndex: 1, PortIndex: 35},
+		want: 301350,
+	}, {
+		desc:    "port index too large",
+		kind:    namer.LogicalChannelEthernet,
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 100},
+		wantErr: true,
+	}, {
+		desc:    "slot index too large",
+		kind:    namer.LogicalChannelEthernet,
+		pp:      &namer.PortParams{SlotIndex: uintPtr(8)},
+		wantErr: true,
+	}, {
+		desc:    "unknown kind",
+		kind:    namer.LogicalChannelKind("unknown"),
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := jn.LogicalChannelIndex(test.pp, test.kind)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("LogicalChannelIndex(%v,%v) got error %v, want error %v", test.pp, test.kind, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("LogicalChannelIndex(%v,%v) got %d, want %d", test.pp, test.kind, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPortASIC(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc          string
+		hardwareModel string
+		pp            *namer.PortParams
+		want          string
+		wantErr       bool
+	}{{
+		desc: "modular",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 13},
+		want: "FPC1:PIC0:PE0",
+	}, {
+		desc: "channelized",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 13, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: "FPC1:PIC0:PE0",
+	}, {
+		desc:          "fixed form factor",
+		hardwareModel: "PTX10001-36MR",
+		pp:            &namer.PortParams{PortIndex: 2},
+		want:          "FPC0:PIC0:PE0",
+	}, {
+		desc:    "port beyond last integrated circuit",
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 144},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			n := &Namer{HardwareModel: test.hardwareModel}
+			got, err := n.PortASIC(test.pp)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("PortASIC(%v) got error %v, want error %v", test.pp, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("PortASIC(%v) got %q, want %q", test.pp, got, test.want)
+			}
+		})
+	}
+}
+
 func TestLinecard(t *testing.T) {
 	tests := []struct {
 		desc  string
@@ -211,6 +767,16 @@ func TestLinecard(t *testing.T) {
 			t.Fatalf("Linecard(8) got error %v, want substring %q", err, wantErr)
 		}
 	})
+
+	t.Run("fixed form factor", func(t *testing.T) {
+		n := &Namer{HardwareModel: "MX204"}
+		if got, err := n.Linecard(0); err != nil || got != "FPC0" {
+			t.Errorf("Linecard(0) got (%q, %v), want FPC0", got, err)
+		}
+		if _, err := n.Linecard(1); err == nil {
+			t.Errorf("Linecard(1) got no error, want error")
+		}
+	})
 }
 
 func TestControllerCard(t *testing.T) {
@@ -279,4 +845,399 @@ func TestFabric(t *testing.T) {
 			t.Fatalf("Fabric(6) got error %v, want substring %q", err, wantErr)
 		}
 	})
+
+	t.Run("fixed form factor", func(t *testing.T) {
+		n := &Namer{HardwareModel: "MX204"}
+		if _, err := n.Fabric(0); !errors.Is(err, namer.ErrUnsupportedEntity) {
+			t.Errorf("Fabric(0) got error %v, want %v", err, namer.ErrUnsupportedEntity)
+		}
+	})
+}
+
+func TestManagementInterface(t *testing.T) {
+	tests := []struct {
+		desc           string
+		hardwareModel  string
+		controllerCard uint
+		index          uint
+		want           string
+		wantErr        bool
+	}{{
+		desc:           "modular",
+		hardwareModel:  "PTX10008",
+		controllerCard: 1,
+		index:          0,
+		want:           "re1:mgmt-0",
+	}, {
+		desc:           "fixed Junos OS Evolved",
+		hardwareModel:  "PTX10001-36MR",
+		controllerCard: 0,
+		index:          1,
+		want:           "re0:mgmt-1",
+	}, {
+		desc:           "fixed Junos OS",
+		hardwareModel:  "QFX5120-48Y",
+		controllerCard: 0,
+		index:          0,
+		want:           "em0",
+	}, {
+		desc:           "fixed Junos OS with fxp",
+		hardwareModel:  "MX204",
+		controllerCard: 0,
+		index:          0,
+		want:           "fxp0",
+	}, {
+		desc:           "fixed Junos OS - second controller card",
+		hardwareModel:  "MX204",
+		controllerCard: 1,
+		index:          0,
+		wantErr:        true,
+	}, {
+		desc:           "fixed - second controller card",
+		hardwareModel:  "PTX10001-36MR",
+		controllerCard: 1,
+		index:          0,
+		wantErr:        true,
+	}, {
+		desc:           "over max",
+		controllerCard: 0,
+		index:          2,
+		wantErr:        true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			n := &Namer{HardwareModel: test.hardwareModel}
+			got, err := n.ManagementInterface(test.controllerCard, test.index)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ManagementInterface(%d,%d) got error %v, want error %v", test.controllerCard, test.index, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("ManagementInterface(%d,%d) got %q, want %q", test.controllerCard, test.index, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPowerSupplyAndFans(t *testing.T) {
+	tests := []struct {
+		desc          string
+		hardwareModel string
+		nameFn        func(*Namer) (string, error)
+		want          string
+		wantErr       bool
+	}{{
+		desc:          "power supply - fixed",
+		hardwareModel: "PTX10001-36MR",
+		nameFn:        func(n *Namer) (string, error) { return n.PowerSupply(1) },
+		want:          "PSM 1",
+	}, {
+		desc:          "power supply - fixed over max",
+		hardwareModel: "PTX10001-36MR",
+		nameFn:        func(n *Namer) (string, error) { return n.PowerSupply(2) },
+		wantErr:       true,
+	}, {
+		desc:          "power supply - modular",
+		hardwareModel: "PTX10008",
+		nameFn:        func(n *Namer) (string, error) { return n.PowerSupply(5) },
+		want:          "PSM 5",
+	}, {
+		desc:          "fan tray",
+		hardwareModel: "PTX10008",
+		nameFn:        func(n *Namer) (string, error) { return n.FanTray(1) },
+		want:          "Fan Tray 1",
+	}, {
+		desc:          "fan",
+		hardwareModel: "PTX10008",
+		nameFn:        func(n *Namer) (string, error) { return n.Fan(1, 4) },
+		want:          "Fan Tray 1 Fan 4",
+	}, {
+		desc:          "fan over max",
+		hardwareModel: "PTX10008",
+		nameFn:        func(n *Namer) (string, error) { return n.Fan(0, 5) },
+		wantErr:       true,
+	}, {
+		desc:          "fan in invalid tray",
+		hardwareModel: "PTX10008",
+		nameFn:        func(n *Namer) (string, error) { return n.Fan(2, 0) },
+		wantErr:       true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn(&Namer{HardwareModel: test.hardwareModel})
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestIntegratedCircuitAndCPU(t *testing.T) {
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr bool
+	}{{
+		desc:   "integrated circuit",
+		nameFn: func() (string, error) { return jn.IntegratedCircuit(1, 1) },
+		want:   "FPC1:PIC0:PE1",
+	}, {
+		desc:    "integrated circuit over max",
+		nameFn:  func() (string, error) { return jn.IntegratedCircuit(0, maxIntegratedCircuitIndex+1) },
+		wantErr: true,
+	}, {
+		desc:    "integrated circuit on invalid linecard",
+		nameFn:  func() (string, error) { return jn.IntegratedCircuit(8, 0) },
+		wantErr: true,
+	}, {
+		desc:   "controller card CPU",
+		nameFn: func() (string, error) { return jn.CPU(namer.KindControllerCard, 0, 0) },
+		want:   "RE0:CPU0",
+	}, {
+		desc:   "linecard CPU",
+		nameFn: func() (string, error) { return jn.CPU(namer.KindLinecard, 0, 0) },
+		want:   "FPC0:CPU0",
+	}, {
+		desc:    "CPU over max",
+		nameFn:  func() (string, error) { return jn.CPU(namer.KindLinecard, 0, maxCPUIndex+1) },
+		wantErr: true,
+	}, {
+		desc:    "CPU on fabric",
+		nameFn:  func() (string, error) { return jn.CPU(namer.KindFabric, 0, 0) },
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestChassisAndBackplane(t *testing.T) {
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr bool
+	}{{
+		desc:   "chassis",
+		nameFn: func() (string, error) { return jn.Chassis(0) },
+		want:   "CHASSIS0",
+	}, {
+		desc:    "chassis over max",
+		nameFn:  func() (string, error) { return jn.Chassis(1) },
+		wantErr: true,
+	}, {
+		desc:   "backplane",
+		nameFn: func() (string, error) { return jn.Backplane(0) },
+		want:   "Midplane",
+	}, {
+		desc:    "backplane of invalid chassis",
+		nameFn:  func() (string, error) { return jn.Backplane(1) },
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseIndex(t *testing.T) {
+	tests := []struct {
+		desc string
+		kind namer.EntityKind
+		name string
+		want uint
+	}{{
+		desc: "PSM 1",
+		kind: namer.KindPowerSupply,
+		name: "PSM 1",
+		want: 1,
+	}, {
+		desc: "Fan Tray 1",
+		kind: namer.KindFanTray,
+		name: "Fan Tray 1",
+		want: 1,
+	}, {
+		desc: "irb.100",
+		kind: namer.KindVLANInterface,
+		name: "irb.100",
+		want: 100,
+	}, {
+		desc: "lo0",
+		kind: namer.KindLoopback,
+		name: "lo0",
+		want: 0,
+	}, {
+		desc: "ae3",
+		kind: namer.KindAggregate,
+		name: "ae3",
+		want: 3,
+	}, {
+		desc: "ae3.0",
+		kind: namer.KindAggregateMember,
+		name: "ae3.0",
+		want: 3,
+	}, {
+		desc: "FPC7",
+		kind: namer.KindLinecard,
+		name: "FPC7",
+		want: 7,
+	}, {
+		desc: "RE1",
+		kind: namer.KindControllerCard,
+		name: "RE1",
+		want: 1,
+	}, {
+		desc: "SIB5",
+		kind: namer.KindFabric,
+		name: "SIB5",
+		want: 5,
+	}, {
+		desc: "CHASSIS0",
+		kind: namer.KindChassis,
+		name: "CHASSIS0",
+		want: 0,
+	}, {
+		desc: "Midplane",
+		kind: namer.KindBackplane,
+		name: "Midplane",
+		want: 0,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := jn.ParseIndex(test.kind, test.name)
+			if err != nil {
+				t.Fatalf("ParseIndex(%v,%q) got error: %v", test.kind, test.name, err)
+			}
+			if got != test.want {
+				t.Errorf("ParseIndex(%v,%q) got %d, want %d", test.kind, test.name, got, test.want)
+			}
+		})
+	}
+
+	invalidTests := []struct {
+		kind namer.EntityKind
+		name string
+	}{
+		{namer.KindLoopback, "lo1"},
+		{namer.KindAggregateMember, "ae3"},
+		{namer.KindAggregate, "ae3.0"},
+		{namer.KindFabric, "SIB6"},
+		{namer.KindChassis, "CHASSIS1"},
+	}
+	for _, test := range invalidTests {
+		t.Run("invalid "+test.name, func(t *testing.T) {
+			if _, err := jn.ParseIndex(test.kind, test.name); err == nil {
+				t.Fatalf("ParseIndex(%v,%q) got no error", test.kind, test.name)
+			}
+		})
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	got, err := jn.Capabilities()
+	if err != nil {
+		t.Fatalf("Capabilities() got error: %v", err)
+	}
+	if got.MaxLoopbacks != 1 {
+		t.Errorf("Capabilities() got MaxLoopbacks %d, want 1", got.MaxLoopbacks)
+	}
+	if got.MaxAggregates != 1152 {
+		t.Errorf("Capabilities() got MaxAggregates %d, want 1152", got.MaxAggregates)
+	}
+	if got.MaxLinecards != 8 {
+		t.Errorf("Capabilities() got MaxLinecards %d, want 8", got.MaxLinecards)
+	}
+	if got.MaxControllerCards != 2 {
+		t.Errorf("Capabilities() got MaxControllerCards %d, want 2", got.MaxControllerCards)
+	}
+	if got.MaxFabrics != 6 {
+		t.Errorf("Capabilities() got MaxFabrics %d, want 6", got.MaxFabrics)
+	}
+	if got.MaxPowerSupplies != 6 {
+		t.Errorf("Capabilities() got MaxPowerSupplies %d, want 6", got.MaxPowerSupplies)
+	}
+	if got.MaxFanTrays != 2 {
+		t.Errorf("Capabilities() got MaxFanTrays %d, want 2", got.MaxFanTrays)
+	}
+
+	fixed := &Namer{HardwareModel: "MX204"}
+	got, err = fixed.Capabilities()
+	if err != nil {
+		t.Fatalf("Capabilities() of %s got error: %v", fixed.HardwareModel, err)
+	}
+	if got.MaxLinecards != 1 || got.MaxControllerCards != 1 || got.MaxFabrics != 0 {
+		t.Errorf("Capabilities() of %s got %d linecards, %d controller cards, and %d fabrics, want 1, 1, and 0", fixed.HardwareModel, got.MaxLinecards, got.MaxControllerCards, got.MaxFabrics)
+	}
+}
+
+func TestIsFixedFormFactor(t *testing.T) {
+	tests := []struct {
+		hardwareModel string
+		want          bool
+	}{
+		{"PTX10001-36MR", true},
+		{"PTX10008", false},
+		{"JNP10001-36MR", true},
+		{"JNP10008", false},
+		{"MX204", true},
+		{"QFX5120-48Y-8C", true},
+		{"ACX5448-D", true},
+		{"", false},
+		{"unknown", false},
+	}
+	for _, test := range tests {
+		n := &Namer{HardwareModel: test.hardwareModel}
+		if got := n.IsFixedFormFactor(); got != test.want {
+			t.Errorf("IsFixedFormFactor() with hardware model %q got %v, want %v", test.hardwareModel, got, test.want)
+		}
+	}
+}
+
+func TestUnlistedHardwareModel(t *testing.T) {
+	for _, hwm := range []string{"", "PTX10001-36MR", "JNP10001-36MR", "JNP10008", "mx204", "QFX5120-48Y-8C", "ACX5448-D"} {
+		n := &Namer{HardwareModel: hwm}
+		if _, err := n.Capabilities(); err != nil {
+			t.Errorf("Capabilities() with hardware model %q got error: %v", hwm, err)
+		}
+	}
+	for _, hwm := range []string{"MX480", "PTX1000", "PTX10004"} {
+		n := &Namer{HardwareModel: hwm}
+		if _, err := n.LoopbackInterface(0); err != nil {
+			t.Errorf("LoopbackInterface(0) with hardware model %q got error: %v", hwm, err)
+		}
+		if _, err := n.AggregateInterface(0); err != nil {
+			t.Errorf("AggregateInterface(0) with hardware model %q got error: %v", hwm, err)
+		}
+		if _, err := n.Capabilities(); !errors.Is(err, namer.ErrUnsupportedHardwareModel) {
+			t.Errorf("Capabilities() with hardware model %q got error %v, want %v", hwm, err, namer.ErrUnsupportedHardwareModel)
+		}
+	}
+}
+
+func TestConformance(t *testing.T) {
+	namertest.TestNamer(t, jn)
+	t.Run("fixed form factor", func(t *testing.T) {
+		namertest.TestNamer(t, &Namer{HardwareModel: "PTX10001-36MR"})
+	})
+	t.Run("Junos OS", func(t *testing.T) {
+		namertest.TestNamer(t, &Namer{HardwareModel: "MX204"})
+	})
 }
@@ -0,0 +1,95 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namerutil
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/openconfig/entity-naming/namer"
+)
+
+// HardwareModel describes the properties of a family of hardware models that
+// every built-in Namer needs. Each Namer describes its families with a struct
+// that embeds HardwareModel.
+type HardwareModel struct {
+	// Prefixes are the prefixes of the normalized names of the hardware models
+	// in the family, as returned by NormalizeHardwareModel.
+	Prefixes []string
+	// FixedFormFactor indicates whether the hardware models have a fixed form
+	// factor.
+	FixedFormFactor bool
+	// NumLinecards, NumControllerCards, and NumFabrics are the numbers of
+	// linecard, controller card, and fabric slots. Fixed form factor devices
+	// typically have a single controller card and no fabrics.
+	NumLinecards, NumControllerCards, NumFabrics uint
+	// NumPowerSupplies, NumFanTrays, and NumFansPerTray are the numbers of
+	// power supplies, fan trays, and fans in each fan tray.
+	NumPowerSupplies, NumFanTrays, NumFansPerTray uint
+	// PortsPerASIC is the number of consecutively numbered ports of each
+	// linecard, or of the device if it has a fixed form factor, that are
+	// served by each integrated circuit.
+	PortsPerASIC uint
+}
+
+func (hm *HardwareModel) hardwareModel() *HardwareModel {
+	return hm
+}
+
+// HardwareModelFamily is implemented by pointers to the structs that embed
+// HardwareModel.
+type HardwareModelFamily interface {
+	hardwareModel() *HardwareModel
+}
+
+var separatorRE = regexp.MustCompile(`[\s_]+`)
+
+// NormalizeHardwareModel returns the hardware model name in upper case, with
+// surrounding space removed, each run of spaces and underscores replaced by a
+// hyphen, and the first of the SKU prefixes that begins it removed. The SKU
+// prefixes must be normalized.
+func NormalizeHardwareModel(name string, skuPrefixes ...string) string {
+	name = separatorRE.ReplaceAllString(strings.ToUpper(strings.TrimSpace(name)), "-")
+	for _, prefix := range skuPrefixes {
+		if trimmed, ok := strings.CutPrefix(name, prefix); ok {
+			return trimmed
+		}
+	}
+	return name
+}
+
+// FindHardwareModel returns the first of the families that has a prefix of
+// the normalized hardware model name, or the default family if the name is
+// empty. If the name is not empty and no family matches it, it returns the
+// default family and an error wrapping namer.ErrUnsupportedHardwareModel, so
+// that Namers whose hardware model was not validated can still name entities.
+func FindHardwareModel[F any, PF interface {
+	*F
+	HardwareModelFamily
+}](families []F, defaultFamily *F, name string, skuPrefixes ...string) (*F, error) {
+	if name == "" {
+		return defaultFamily, nil
+	}
+	normalized := NormalizeHardwareModel(name, skuPrefixes...)
+	for i := range families {
+		for _, prefix := range PF(&families[i]).hardwareModel().Prefixes {
+			if strings.HasPrefix(normalized, prefix) {
+				return &families[i], nil
+			}
+		}
+	}
+	return defaultFamily, fmt.Errorf("%w %q", namer.ErrUnsupportedHardwareModel, name)
+}
@@ -0,0 +1,67 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namerutil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/openconfig/entity-naming/namer"
+)
+
+func TestNormalizeHardwareModel(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"DCS-7280CR3-32P4", "7280CR3-32P4"},
+		{"ccs-720xp-48zc2", "720XP-48ZC2"},
+		{" 7220 IXR-D3L ", "7220-IXR-D3L"},
+		{"7220_IXR  D2L", "7220-IXR-D2L"},
+		{"DCS", "DCS"},
+	}
+	for _, test := range tests {
+		if got := NormalizeHardwareModel(test.name, "DCS-", "CCS-"); got != test.want {
+			t.Errorf("NormalizeHardwareModel(%q) got %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestFindHardwareModel(t *testing.T) {
+	families := []HardwareModel{
+		{Prefixes: []string{"7060DX", "7060PX"}, PortsPerASIC: 1},
+		{Prefixes: []string{"7060"}, PortsPerASIC: 2},
+	}
+	defaultFamily := &HardwareModel{PortsPerASIC: 3}
+	tests := []struct {
+		name    string
+		want    uint
+		wantErr bool
+	}{
+		{name: "", want: 3},
+		{name: "DCS-7060PX4-32", want: 1},
+		{name: "7060cx-32s", want: 2},
+		{name: "7280R3", want: 3, wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := FindHardwareModel(families, defaultFamily, test.name, "DCS-")
+		if gotErr := errors.Is(err, namer.ErrUnsupportedHardwareModel); gotErr != test.wantErr {
+			t.Errorf("FindHardwareModel(%q) got error %v, want error %v", test.name, err, test.wantErr)
+		}
+		if got.PortsPerASIC != test.want {
+			t.Errorf("FindHardwareModel(%q) got family %+v, want PortsPerASIC %d", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package namerutil provides helpers shared by the built-in vendor Namers.
+package namerutil
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/openconfig/entity-naming/namer"
+)
+
+// Namer is implemented by the built-in Namers, which implement the core
+// interface and every extension interface but HardwareModelValidator: they
+// name the entities of unlisted hardware models as those of a default family.
+type Namer interface {
+	namer.Namer
+	namer.PortParser
+	namer.IndexParser
+	namer.CapabilitiesReporter
+	namer.SubinterfaceNamer
+	namer.VLANInterfaceNamer
+	namer.TunnelInterfaceNamer
+	namer.ManagementInterfaceNamer
+	namer.EnvironmentNamer
+	namer.ChassisNamer
+	namer.ChipNamer
+	namer.TransceiverNamer
+	namer.OpticalChannelNamer
+	namer.LogicalChannelIndexer
+	namer.PortASICNamer
+}
+
+// MatchIndices matches the name against the regular expression and parses
+// each of its subexpressions as an unsigned decimal integer. Subexpressions
+// that do not participate in the match are returned as nil. It returns false
+// if the name does not match or if any number has a leading zero.
+func MatchIndices(re *regexp.Regexp, name string) ([]*uint, bool) {
+	subs := re.FindStringSubmatch(name)
+	if subs == nil {
+		return nil, false
+	}
+	indices := make([]*uint, len(subs)-1)
+	for i, sub := range subs[1:] {
+		if sub == "" {
+			continue
+		}
+		if len(sub) > 1 && sub[0] == '0' {
+			return nil, false
+		}
+		v, err := strconv.ParseUint(sub, 10, 0)
+		if err != nil {
+			return nil, false
+		}
+		index := uint(v)
+		indices[i] = &index
+	}
+	return indices, true
+}
+
+// InvertIndex returns the zero-based index for which nameFn returns the
+// specified name. The regular expression must have a single subexpression
+// that matches the number in the name, which exceeds the index by offset.
+func InvertIndex(name string, re *regexp.Regexp, offset uint, nameFn func(uint) (string, error)) (uint, error) {
+	indices, ok := MatchIndices(re, name)
+	if !ok || *indices[0] < offset {
+		return 0, fmt.Errorf("name %q does not match %v", name, re)
+	}
+	index := *indices[0] - offset
+	got, err := nameFn(index)
+	if err != nil {
+		return 0, err
+	}
+	if got != name {
+		return 0, fmt.Errorf("name %q does not match %v", name, re)
+	}
+	return index, nil
+}
+
+// InvertSingleton returns the zero index if the name is the specified name of
+// an entity of which there is only one.
+func InvertSingleton(name, want string) (uint, error) {
+	if name != want {
+		return 0, fmt.Errorf("name %q is not %q", name, want)
+	}
+	return 0, nil
+}
+
+// Card returns the name of the card of the specified kind with the specified
+// zero-based index, or an error if the kind is not a kind of card or no such
+// name exists.
+func Card(n namer.Namer, kind namer.EntityKind, index uint) (string, error) {
+	switch kind {
+	case namer.KindLinecard:
+		return n.Linecard(index)
+	case namer.KindControllerCard:
+		return n.ControllerCard(index)
+	}
+	return "", fmt.Errorf("%s is not a kind of card: %w", kind, namer.ErrUnsupportedEntity)
+}
+
+// Limits of the indices packed by PackLogicalChannelIndex.
+const (
+	maxPackedPortIndex    = 99
+	maxPackedChannelIndex = 8
+)
+
+// PackLogicalChannelIndex returns the sum of the base, the slot times 1000,
+// the port index times 10, and one more than the channel index, or zero if
+// the port is unchannelized. It returns an error if the port or channel index
+// does not fit in its digits. The caller must ensure that the slot does not
+// overflow into the base.
+func PackLogicalChannelIndex(base uint32, slot uint, pp *namer.PortParams) (uint32, error) {
+	if pp.PortIndex > maxPackedPortIndex {
+		return 0, &namer.IndexOutOfRangeError{Entity: namer.KindPort, Index: int(pp.PortIndex), Max: maxPackedPortIndex}
+	}
+	var channel uint
+	if pp.ChannelIndex != nil {
+		if *pp.ChannelIndex > maxPackedChannelIndex {
+			return 0, &namer.IndexOutOfRangeError{Entity: namer.KindChannel, Index: int(*pp.ChannelIndex), Max: maxPackedChannelIndex}
+		}
+		channel = *pp.ChannelIndex + 1
+	}
+	return base + uint32(slot*1000+pp.PortIndex*10+channel), nil
+}
@@ -17,34 +17,99 @@ package nokia
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
-	"github.com/openconfig/entity-naming/internal/namer"
+	"github.com/openconfig/entity-naming/internal/namerutil"
+	"github.com/openconfig/entity-naming/namer"
+	"github.com/openconfig/entity-naming/oc"
 )
 
-var _ namer.Namer = (*Namer)(nil)
+var _ namerutil.Namer = (*Namer)(nil)
+
+const (
+	maxLoopbackIndex          = 255
+	maxAggregateIndex         = 127
+	maxChassisIndex           = 0
+	maxIntegratedCircuitIndex = 3
+	maxCPUIndex               = 0
+	maxOpticalChannelIndex    = 1
+	maxSubinterfaceIndex      = 9999
+	minVLANID                 = 1
+	maxVLANID                 = 4094
+	maxManagementIndex        = 0
+)
+
+// chassisName is the name of the only chassis.
+const chassisName = "Chassis"
+
+var portSpeeds = []oc.E_IfEthernet_ETHERNET_SPEED{
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_1GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_40GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_50GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_200GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB,
+	oc.IfEthernet_ETHERNET_SPEED_SPEED_800GB,
+}
+
+// logicalChannelBases give each kind of Nokia logical channel its own ten
+// thousands, as Nokia chassis have fewer than ten linecard slots to pack into
+// the index.
+var logicalChannelBases = map[namer.LogicalChannelKind]uint32{
+	namer.LogicalChannelEthernet: 10000,
+	namer.LogicalChannelOTN:      20000,
+	namer.LogicalChannelCoherent: 30000,
+}
+
+// skuPrefixes are the prefixes of the Nokia chassis types that inventories may
+// report, such as "Nokia 7220 IXR-D3L", that are not part of the hardware
+// model name.
+var skuPrefixes = []string{"NOKIA-"}
+
+// hardwareModels are the known Nokia hardware model families: the 7220 IXR-D
+// fixed switches and the 7250 IXR-10 modular chassis. SR Linux reports chassis
+// types with spaces, such as "7220 IXR-D3L", which are normalized to hyphens.
+var hardwareModels = []namerutil.HardwareModel{
+	{Prefixes: []string{"7220-IXR-D"}, FixedFormFactor: true, NumLinecards: 0, NumControllerCards: 1, NumFabrics: 0, NumPowerSupplies: 2, NumFanTrays: 4, NumFansPerTray: 1, PortsPerASIC: 64},
+	{Prefixes: []string{"7250-IXR-10"}, NumLinecards: 8, NumControllerCards: 2, NumFabrics: 8, NumPowerSupplies: 6, NumFanTrays: 3, NumFansPerTray: 6, PortsPerASIC: 9},
+}
+
+// defaultHardwareModel describes the device if its hardware model is not
+// given: a 7250 IXR-10.
+var defaultHardwareModel = hardwareModels[1]
 
 // Namer is a Nokia implementation of the Namer interface.
 type Namer struct {
 	HardwareModel string
 }
 
+// family returns the Nokia family of the hardware model of the device, or
+// the default family if the hardware model is not listed, so that names that
+// do not depend on the hardware model are available for every device.
+// A "Nokia" prefix, case, and the choice of separators in the hardware model
+// are ignored.
+func (n *Namer) family() *namerutil.HardwareModel {
+	hwm, _ := namerutil.FindHardwareModel(hardwareModels, &defaultHardwareModel, n.HardwareModel, skuPrefixes...)
+	return hwm
+}
+
 // LoopbackInterface is an implementation of namer.LoopbackInterface.
 func (n *Namer) LoopbackInterface(index uint) (string, error) {
-	const maxIndex = 255
-	if index > maxIndex {
+	if index > maxLoopbackIndex {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Nokia loopback index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindLoopback, Index: int(index), Max: maxLoopbackIndex})
 	}
 	return fmt.Sprintf("lo%d", index), nil
 }
 
 // AggregateInterface is an implementation of namer.AggregateInterface.
 func (n *Namer) AggregateInterface(index uint) (string, error) {
-	const maxIndex = 127
-	if index > maxIndex {
+	if index > maxAggregateIndex {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Nokia aggregate index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindAggregate, Index: int(index), Max: maxAggregateIndex})
 	}
 	return fmt.Sprintf("lag%d", index+1), nil
 }
@@ -58,36 +123,167 @@ func (n *Namer) AggregateMemberInterface(index uint) (string, error) {
 	return name + ".0", nil
 }
 
+// Subinterface is an implementation of namer.Subinterface.
+func (n *Namer) Subinterface(parent string, index uint) (string, error) {
+	if index > maxSubinterfaceIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindSubinterface, Index: int(index), Max: maxSubinterfaceIndex})
+	}
+	return fmt.Sprintf("%s.%d", parent, index), nil
+}
+
+// VLANInterface is an implementation of namer.VLANInterface.
+func (n *Namer) VLANInterface(vlanID uint) (string, error) {
+	if vlanID < minVLANID || vlanID > maxVLANID {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindVLANInterface, Index: int(vlanID), Max: maxVLANID})
+	}
+	return n.Subinterface("irb0", vlanID)
+}
+
+// TunnelInterface is an implementation of namer.TunnelInterface.
+func (n *Namer) TunnelInterface(tp *namer.TunnelParams) (string, error) {
+	//nolint:staticcheck // ST1005 string begins with proper noun
+	return "", fmt.Errorf("Nokia does not support %s tunnel interfaces: %w", tp.Kind, namer.ErrUnsupportedEntity)
+}
+
+// ManagementInterface is an implementation of namer.ManagementInterface.
+// Nokia has a single management interface, which is served by the active
+// controller card, so its name does not depend on the controller card.
+func (n *Namer) ManagementInterface(controllerCardIndex, index uint) (string, error) {
+	if _, err := n.ControllerCard(controllerCardIndex); err != nil {
+		return "", err
+	}
+	if index > maxManagementIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindManagementInterface, Index: int(index), Max: maxManagementIndex})
+	}
+	return "mgmt0", nil
+}
+
 // Linecard is an implementation of namer.Linecard.
 func (n *Namer) Linecard(index uint) (string, error) {
-	const maxIndex = 7
-	if index > maxIndex {
+	hwm := n.family()
+	if hwm.NumLinecards == 0 {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Nokia linecard index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Nokia fixed form factor switches have no linecards: %w", namer.ErrUnsupportedEntity)
+	}
+	if index >= hwm.NumLinecards {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindLinecard, Index: int(index), Max: int(hwm.NumLinecards) - 1})
 	}
 	return fmt.Sprintf("Linecard%d", index+1), nil
 }
 
 // ControllerCard is an implementation of namer.ControllerCard.
 func (n *Namer) ControllerCard(index uint) (string, error) {
-	const maxIndex = 1
-	if index > maxIndex {
+	hwm := n.family()
+	if index >= hwm.NumControllerCards {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Nokia controller card index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindControllerCard, Index: int(index), Max: int(hwm.NumControllerCards) - 1})
 	}
 	return fmt.Sprintf("Supervisor%d", index+1), nil
 }
 
 // Fabric is an implementation of namer.Fabric.
 func (n *Namer) Fabric(index uint) (string, error) {
-	const maxIndex = 7
-	if index > maxIndex {
+	hwm := n.family()
+	if hwm.NumFabrics == 0 {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Nokia fixed form factor switches have no fabrics: %w", namer.ErrUnsupportedEntity)
+	}
+	if index >= hwm.NumFabrics {
 		//nolint:staticcheck // ST1005 string begins with proper noun
-		return "", fmt.Errorf("Nokia fabric index cannot exceed %d, got %d", maxIndex, index)
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindFabric, Index: int(index), Max: int(hwm.NumFabrics) - 1})
 	}
 	return fmt.Sprintf("Fabric%d", index+1), nil
 }
 
+// PowerSupply is an implementation of namer.PowerSupply.
+func (n *Namer) PowerSupply(index uint) (string, error) {
+	hwm := n.family()
+	if index >= hwm.NumPowerSupplies {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindPowerSupply, Index: int(index), Max: int(hwm.NumPowerSupplies) - 1})
+	}
+	return fmt.Sprintf("PowerSupply%d", index+1), nil
+}
+
+// FanTray is an implementation of namer.FanTray.
+func (n *Namer) FanTray(index uint) (string, error) {
+	hwm := n.family()
+	if index >= hwm.NumFanTrays {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindFanTray, Index: int(index), Max: int(hwm.NumFanTrays) - 1})
+	}
+	return fmt.Sprintf("FanTray%d", index+1), nil
+}
+
+// Fan is an implementation of namer.Fan.
+func (n *Namer) Fan(trayIndex, fanIndex uint) (string, error) {
+	if _, err := n.FanTray(trayIndex); err != nil {
+		return "", err
+	}
+	hwm := n.family()
+	if fanIndex >= hwm.NumFansPerTray {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindFan, Index: int(fanIndex), Max: int(hwm.NumFansPerTray) - 1})
+	}
+	return fmt.Sprintf("FanTray%d-Fan%d", trayIndex+1, fanIndex+1), nil
+}
+
+// IntegratedCircuit is an implementation of namer.IntegratedCircuit.
+// On a fixed form factor 7220 the ASICs belong to the chassis, so linecard
+// index zero is the only valid index and names carry no linecard prefix.
+func (n *Namer) IntegratedCircuit(linecardIndex, chipIndex uint) (string, error) {
+	var prefix string
+	if n.IsFixedFormFactor() {
+		if linecardIndex > 0 {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindLinecard, Index: int(linecardIndex), Max: 0})
+		}
+	} else {
+		lc, err := n.Linecard(linecardIndex)
+		if err != nil {
+			return "", err
+		}
+		prefix = lc + "-"
+	}
+	if chipIndex > maxIntegratedCircuitIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindIntegratedCircuit, Index: int(chipIndex), Max: maxIntegratedCircuitIndex})
+	}
+	return fmt.Sprintf("%sAsic%d", prefix, chipIndex), nil
+}
+
+// CPU is an implementation of namer.CPU.
+func (n *Namer) CPU(cardKind namer.EntityKind, cardIndex, cpuIndex uint) (string, error) {
+	card, err := namerutil.Card(n, cardKind, cardIndex)
+	if err != nil {
+		return "", err
+	}
+	if cpuIndex > maxCPUIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindCPU, Index: int(cpuIndex), Max: maxCPUIndex})
+	}
+	return fmt.Sprintf("%s-CPU%d", card, cpuIndex), nil
+}
+
+// Chassis is an implementation of namer.Chassis.
+func (n *Namer) Chassis(index uint) (string, error) {
+	if index > maxChassisIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindChassis, Index: int(index), Max: maxChassisIndex})
+	}
+	return chassisName, nil
+}
+
+// Backplane is an implementation of namer.Backplane.
+func (n *Namer) Backplane(uint) (string, error) {
+	//nolint:staticcheck // ST1005 string begins with proper noun
+	return "", fmt.Errorf("Nokia backplanes are not supported: %w", namer.ErrUnsupportedEntity)
+}
+
 // Port is an implementation of namer.Port.
 func (n *Namer) Port(pp *namer.PortParams) (string, error) {
 	var nameBuilder strings.Builder
@@ -104,10 +300,159 @@ func (n *Namer) Port(pp *namer.PortParams) (string, error) {
 	return nameBuilder.String(), nil
 }
 
+var portRE = regexp.MustCompile(`^et-(\d+)/(\d+)(?:/(\d+))?$`)
+
+// ParsePort is an implementation of namer.ParsePort.
+// Nokia does not distinguish unchannelized and unchannelizable port names,
+// so a port without a channel is parsed as channelizable.
+func (n *Namer) ParsePort(name string) (*namer.PortParams, error) {
+	indices, ok := namerutil.MatchIndices(portRE, name)
+	if !ok {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return nil, fmt.Errorf("Nokia port name %q is invalid", name)
+	}
+	for _, index := range indices {
+		if index != nil && *index == 0 {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return nil, fmt.Errorf("Nokia port name %q has a zero number", name)
+		}
+	}
+	pp := &namer.PortParams{
+		PortIndex:     *indices[1] - 1,
+		Channelizable: true,
+	}
+	if n.IsFixedFormFactor() {
+		if *indices[0] != 1 {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return nil, fmt.Errorf("Nokia port name %q has a slot other than 1 on a fixed form factor device", name)
+		}
+	} else {
+		slotIndex := *indices[0] - 1
+		pp.SlotIndex = &slotIndex
+	}
+	if indices[2] != nil {
+		channelIndex := *indices[2] - 1
+		pp.ChannelIndex = &channelIndex
+	}
+	return pp, nil
+}
+
+// Transceiver is an implementation of namer.Transceiver.
+// Nokia names transceivers after their unchannelized ports.
+func (n *Namer) Transceiver(pp *namer.PortParams) (string, error) {
+	unchannelized := *pp
+	unchannelized.ChannelIndex = nil
+	return n.Port(&unchannelized)
+}
+
+// OpticalChannel is an implementation of namer.OpticalChannel.
+// Optical channels are named after the slot and port of their transceiver,
+// followed by their one-based number, so that multi-carrier optics have a
+// name for each carrier: optical channel index 0 of port 1/1 is "och-1/1/1".
+func (n *Namer) OpticalChannel(pp *namer.PortParams, index uint) (string, error) {
+	if index > maxOpticalChannelIndex {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return "", fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindOpticalChannel, Index: int(index), Max: maxOpticalChannelIndex})
+	}
+	xcvr, err := n.Transceiver(pp)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("och-%s/%d", strings.TrimPrefix(xcvr, "et-"), index+1), nil
+}
+
+// LogicalChannelIndex is an implementation of namer.LogicalChannelIndex.
+// The index packs the slot, port, and channel of the port into its digits.
+func (n *Namer) LogicalChannelIndex(pp *namer.PortParams, kind namer.LogicalChannelKind) (uint32, error) {
+	base, ok := logicalChannelBases[kind]
+	if !ok {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return 0, fmt.Errorf("Nokia does not support %s logical channels: %w", kind, namer.ErrUnsupportedEntity)
+	}
+	var slot uint
+	if pp.SlotIndex != nil {
+		slot = *pp.SlotIndex
+		if numLinecards := n.family().NumLinecards; slot >= numLinecards {
+			//nolint:staticcheck // ST1005 string begins with proper noun
+			return 0, fmt.Errorf("Nokia %w", &namer.IndexOutOfRangeError{Entity: namer.KindLinecard, Index: int(slot), Max: int(numLinecards) - 1})
+		}
+	}
+	return namerutil.PackLogicalChannelIndex(base, slot, pp)
+}
+
+// PortASIC is an implementation of namer.PortASIC.
+func (n *Namer) PortASIC(pp *namer.PortParams) (string, error) {
+	var linecardIndex uint
+	if pp.SlotIndex != nil {
+		linecardIndex = *pp.SlotIndex
+	}
+	return n.IntegratedCircuit(linecardIndex, pp.PortIndex/n.family().PortsPerASIC)
+}
+
+var (
+	loopbackRE        = regexp.MustCompile(`^lo(\d+)$`)
+	aggregateRE       = regexp.MustCompile(`^lag(\d+)$`)
+	aggregateMemberRE = regexp.MustCompile(`^lag(\d+)\.0$`)
+	linecardRE        = regexp.MustCompile(`^Linecard(\d+)$`)
+	controllerCardRE  = regexp.MustCompile(`^Supervisor(\d+)$`)
+	fabricRE          = regexp.MustCompile(`^Fabric(\d+)$`)
+	vlanInterfaceRE   = regexp.MustCompile(`^irb0\.(\d+)$`)
+	powerSupplyRE     = regexp.MustCompile(`^PowerSupply(\d+)$`)
+	fanTrayRE         = regexp.MustCompile(`^FanTray(\d+)$`)
+)
+
+// ParseIndex is an implementation of namer.ParseIndex.
+func (n *Namer) ParseIndex(kind namer.EntityKind, name string) (uint, error) {
+	switch kind {
+	case namer.KindLoopback:
+		return namerutil.InvertIndex(name, loopbackRE, 0, n.LoopbackInterface)
+	case namer.KindAggregate:
+		return namerutil.InvertIndex(name, aggregateRE, 1, n.AggregateInterface)
+	case namer.KindAggregateMember:
+		return namerutil.InvertIndex(name, aggregateMemberRE, 1, n.AggregateMemberInterface)
+	case namer.KindLinecard:
+		return namerutil.InvertIndex(name, linecardRE, 1, n.Linecard)
+	case namer.KindControllerCard:
+		return namerutil.InvertIndex(name, controllerCardRE, 1, n.ControllerCard)
+	case namer.KindFabric:
+		return namerutil.InvertIndex(name, fabricRE, 1, n.Fabric)
+	case namer.KindVLANInterface:
+		return namerutil.InvertIndex(name, vlanInterfaceRE, 0, n.VLANInterface)
+	case namer.KindPowerSupply:
+		return namerutil.InvertIndex(name, powerSupplyRE, 1, n.PowerSupply)
+	case namer.KindFanTray:
+		return namerutil.InvertIndex(name, fanTrayRE, 1, n.FanTray)
+	case namer.KindChassis:
+		return namerutil.InvertSingleton(name, chassisName)
+	}
+	//nolint:staticcheck // ST1005 string begins with proper noun
+	return 0, fmt.Errorf("Nokia cannot parse the index of a %s: %w", kind, namer.ErrUnsupportedEntity)
+}
+
 // IsFixedFormFactor is an implementation of namer.IsFixedFormFactor.
+// Without a hardware model, the device is assumed to be a 7250 IXR-10.
 func (n *Namer) IsFixedFormFactor() bool {
-	// TODO(nokia): Fill in this implementation.
-	return false
+	return n.family().FixedFormFactor
+}
+
+// Capabilities is an implementation of namer.Capabilities.
+// The limits of hardware models that are not listed are not known.
+func (n *Namer) Capabilities() (*namer.Capabilities, error) {
+	hwm, err := namerutil.FindHardwareModel(hardwareModels, &defaultHardwareModel, n.HardwareModel, skuPrefixes...)
+	if err != nil {
+		//nolint:staticcheck // ST1005 string begins with proper noun
+		return nil, fmt.Errorf("Nokia %w", err)
+	}
+	return &namer.Capabilities{
+		MaxLoopbacks:       maxLoopbackIndex + 1,
+		MaxAggregates:      maxAggregateIndex + 1,
+		MaxLinecards:       hwm.NumLinecards,
+		MaxControllerCards: hwm.NumControllerCards,
+		MaxFabrics:         hwm.NumFabrics,
+		MaxPowerSupplies:   hwm.NumPowerSupplies,
+		MaxFanTrays:        hwm.NumFanTrays,
+		PortSpeeds:         portSpeeds,
+	}, nil
 }
 
 // CommonQoSQueues is an implementation of namer.CommonQoSQueueNames.
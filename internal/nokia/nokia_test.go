@@ -15,10 +15,14 @@
 package nokia
 
 import (
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
-	"github.com/openconfig/entity-naming/internal/namer"
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/entity-naming/namer"
+	"github.com/openconfig/entity-naming/namer/namertest"
 )
 
 var nn = new(Namer)
@@ -54,6 +58,10 @@ func TestLoopbackInterface(t *testing.T) {
 		if wantErr := "exceed"; err == nil || !strings.Contains(err.Error(), wantErr) {
 			t.Fatalf("LoopbackInterface(256) got error %v, want substring %q", err, wantErr)
 		}
+		var rangeErr *namer.IndexOutOfRangeError
+		if !errors.As(err, &rangeErr) || rangeErr.Entity != namer.KindLoopback || rangeErr.Max != maxLoopbackIndex {
+			t.Errorf("LoopbackInterface(256) got error %v, want an IndexOutOfRangeError with max %d", err, maxLoopbackIndex)
+		}
 	})
 }
 
@@ -125,6 +133,105 @@ func TestAggregateMemberInterface(t *testing.T) {
 	})
 }
 
+func TestSubinterface(t *testing.T) {
+	tests := []struct {
+		desc  string
+		index uint
+		want  string
+	}{{
+		desc:  "min",
+		index: 0,
+		want:  "et-1/1.0",
+	}, {
+		desc:  "max",
+		index: 9999,
+		want:  "et-1/1.9999",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := nn.Subinterface("et-1/1", test.index)
+			if err != nil {
+				t.Fatalf("Subinterface(%q,%d) got error: %v", "et-1/1", test.index, err)
+			}
+			if got != test.want {
+				t.Errorf("Subinterface(%q,%d) got %q, want %q", "et-1/1", test.index, got, test.want)
+			}
+		})
+	}
+
+	t.Run("over max", func(t *testing.T) {
+		_, err := nn.Subinterface("et-1/1", 10000)
+		if wantErr := "exceed"; err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("Subinterface(%q,10000) got error %v, want substring %q", "et-1/1", err, wantErr)
+		}
+	})
+}
+
+func TestVLANInterface(t *testing.T) {
+	tests := []struct {
+		desc   string
+		vlanID uint
+		want   string
+	}{{
+		desc:   "min",
+		vlanID: 1,
+		want:   "irb0.1",
+	}, {
+		desc:   "max",
+		vlanID: 4094,
+		want:   "irb0.4094",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := nn.VLANInterface(test.vlanID)
+			if err != nil {
+				t.Fatalf("VLANInterface(%d) got error: %v", test.vlanID, err)
+			}
+			if got != test.want {
+				t.Errorf("VLANInterface(%d) got %q, want %q", test.vlanID, got, test.want)
+			}
+		})
+	}
+
+	for _, vlanID := range []uint{0, 4095} {
+		t.Run(fmt.Sprintf("invalid %d", vlanID), func(t *testing.T) {
+			_, err := nn.VLANInterface(vlanID)
+			var rangeErr *namer.IndexOutOfRangeError
+			if !errors.As(err, &rangeErr) || rangeErr.Entity != namer.KindVLANInterface {
+				t.Errorf("VLANInterface(%d) got error %v, want an IndexOutOfRangeError", vlanID, err)
+			}
+		})
+	}
+}
+
+func TestTunnelInterface(t *testing.T) {
+	tests := []struct {
+		desc    string
+		tp      *namer.TunnelParams
+		want    string
+		wantErr bool
+	}{{
+		desc:    "GRE",
+		tp:      &namer.TunnelParams{Kind: namer.TunnelGRE, Index: 0},
+		wantErr: true,
+	}, {
+		desc:    "MPLS-TE",
+		tp:      &namer.TunnelParams{Kind: namer.TunnelMPLSTE, Index: 0},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := nn.TunnelInterface(test.tp)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("TunnelInterface(%v) got error %v, want error %v", test.tp, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("TunnelInterface(%v) got %q, want %q", test.tp, got, test.want)
+			}
+		})
+	}
+}
+
 func TestPort(t *testing.T) {
 	uintPtr := func(i uint) *uint { return &i }
 
@@ -191,6 +298,229 @@ func TestPort(t *testing.T) {
 	}
 }
 
+func TestParsePort(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc string
+		name string
+		want *namer.PortParams
+	}{{
+		desc: "unchannelized",
+		name: "et-2/4",
+		want: &namer.PortParams{
+			SlotIndex:     uintPtr(1),
+			PortIndex:     3,
+			Channelizable: true,
+		},
+	}, {
+		desc: "channelized",
+		name: "et-2/4/5",
+		want: &namer.PortParams{
+			SlotIndex:     uintPtr(1),
+			PortIndex:     3,
+			ChannelIndex:  uintPtr(4),
+			Channelizable: true,
+		},
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := nn.ParsePort(test.name)
+			if err != nil {
+				t.Fatalf("ParsePort(%q) got error: %v", test.name, err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ParsePort(%q) got unexpected diff (-want +got):\n%s", test.name, diff)
+			}
+		})
+	}
+
+	for _, name := range []string{"et-0/4", "et-2/4/0", "et-2", "lag1"} {
+		t.Run("invalid "+name, func(t *testing.T) {
+			if _, err := nn.ParsePort(name); err == nil || !strings.Contains(err.Error(), name) {
+				t.Fatalf("ParsePort(%q) got error %v, want substring %q", name, err, name)
+			}
+		})
+	}
+}
+
+func TestTransceiver(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc string
+		pp   *namer.PortParams
+		want string
+	}{{
+		desc: "modular",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		want: "et-2/3",
+	}, {
+		desc: "channelized",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: "et-2/3",
+	}, {
+		desc: "fixed form factor",
+		pp:   &namer.PortParams{PortIndex: 2},
+		want: "et-1/3",
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := nn.Transceiver(test.pp)
+			if err != nil {
+				t.Fatalf("Transceiver(%v) got error: %v", test.pp, err)
+			}
+			if got != test.want {
+				t.Errorf("Transceiver(%v) got %q, want %q", test.pp, got, test.want)
+			}
+		})
+	}
+}
+
+func TestOpticalChannel(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc    string
+		pp      *namer.PortParams
+		index   uint
+		want    string
+		wantErr bool
+	}{{
+		desc: "modular",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		want: "och-2/3/1",
+	}, {
+		desc:  "second carrier",
+		pp:    &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		index: 1,
+		want:  "och-2/3/2",
+	}, {
+		desc: "channelized",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: "och-2/3/1",
+	}, {
+		desc: "fixed form factor",
+		pp:   &namer.PortParams{PortIndex: 2},
+		want: "och-1/3/1",
+	}, {
+		desc:    "index over max",
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		index:   maxOpticalChannelIndex + 1,
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := nn.OpticalChannel(test.pp, test.index)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("OpticalChannel(%v,%d) got error %v, want error %v", test.pp, test.index, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("OpticalChannel(%v,%d) got %q, want %q", test.pp, test.index, got, test.want)
+			}
+		})
+	}
+}
+
+func TestLogicalChannelIndex(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc    string
+		kind    namer.LogicalChannelKind
+		pp      *namer.PortParams
+		want    uint32
+		wantErr bool
+	}{{
+		desc: "ethernet",
+		kind: namer.LogicalChannelEthernet,
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		want: 11020,
+	}, {
+		desc: "OTN channel",
+		kind: namer.LogicalChannelOTN,
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: 21024,
+	}, {
+		desc: "coherent fixed form factor",
+		kind: namer.LogicalChannelCoherent,
+		pp:   &namer.PortParams{PortIndex: 35},
+		want: 30350,
+	}, {
+		desc:    "port index too large",
+		kind:    namer.LogicalChannelEthernet,
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 100},
+		wantErr: true,
+	}, {
+		desc:    "slot index too large",
+		kind:    namer.LogicalChannelEthernet,
+		pp:      &namer.PortParams{SlotIndex: uintPtr(8)},
+		wantErr: true,
+	}, {
+		desc:    "unknown kind",
+		kind:    namer.LogicalChannelKind("unknown"),
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := nn.LogicalChannelIndex(test.pp, test.kind)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("LogicalChannelIndex(%v,%v) got error %v, want error %v", test.pp, test.kind, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("LogicalChannelIndex(%v,%v) got %d, want %d", test.pp, test.kind, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPortASIC(t *testing.T) {
+	uintPtr := func(i uint) *uint { return &i }
+
+	tests := []struct {
+		desc          string
+		hardwareModel string
+		pp            *namer.PortParams
+		want          string
+		wantErr       bool
+	}{{
+		desc: "modular",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 13},
+		want: "Linecard2-Asic1",
+	}, {
+		desc: "channelized",
+		pp:   &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 13, ChannelIndex: uintPtr(3), Channelizable: true},
+		want: "Linecard2-Asic1",
+	}, {
+		desc:          "fixed form factor",
+		hardwareModel: "7220 IXR-D2",
+		pp:            &namer.PortParams{PortIndex: 2},
+		want:          "Asic0",
+	}, {
+		desc:          "linecard slot on fixed form factor",
+		hardwareModel: "7220 IXR-D2",
+		pp:            &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 2},
+		wantErr:       true,
+	}, {
+		desc:    "port beyond last integrated circuit",
+		pp:      &namer.PortParams{SlotIndex: uintPtr(1), PortIndex: 36},
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			n := &Namer{HardwareModel: test.hardwareModel}
+			got, err := n.PortASIC(test.pp)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("PortASIC(%v) got error %v, want error %v", test.pp, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("PortASIC(%v) got %q, want %q", test.pp, got, test.want)
+			}
+		})
+	}
+}
+
 func TestLinecard(t *testing.T) {
 	tests := []struct {
 		desc  string
@@ -223,6 +553,13 @@ func TestLinecard(t *testing.T) {
 			t.Fatalf("Linecard(8) got error %v, want substring %q", err, wantErr)
 		}
 	})
+
+	t.Run("fixed form factor", func(t *testing.T) {
+		n := &Namer{HardwareModel: "7220 IXR-D3L"}
+		if _, err := n.Linecard(0); !errors.Is(err, namer.ErrUnsupportedEntity) {
+			t.Errorf("Linecard(0) got error %v, want %v", err, namer.ErrUnsupportedEntity)
+		}
+	})
 }
 
 func TestControllerCard(t *testing.T) {
@@ -291,4 +628,372 @@ func TestFabric(t *testing.T) {
 			t.Fatalf("Fabric(8) got error %v, want substring %q", err, wantErr)
 		}
 	})
+
+	t.Run("fixed form factor", func(t *testing.T) {
+		n := &Namer{HardwareModel: "7220 IXR-D3L"}
+		if _, err := n.Fabric(0); !errors.Is(err, namer.ErrUnsupportedEntity) {
+			t.Errorf("Fabric(0) got error %v, want %v", err, namer.ErrUnsupportedEntity)
+		}
+	})
+}
+
+func TestManagementInterface(t *testing.T) {
+	tests := []struct {
+		desc           string
+		hardwareModel  string
+		controllerCard uint
+		index          uint
+		want           string
+		wantErr        bool
+	}{{
+		desc:           "first",
+		controllerCard: 0,
+		index:          0,
+		want:           "mgmt0",
+	}, {
+		desc:           "second controller card",
+		controllerCard: 1,
+		index:          0,
+		want:           "mgmt0",
+	}, {
+		desc:           "over max",
+		controllerCard: 0,
+		index:          1,
+		wantErr:        true,
+	}, {
+		desc:           "invalid controller card",
+		controllerCard: 2,
+		index:          0,
+		wantErr:        true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			n := &Namer{HardwareModel: test.hardwareModel}
+			got, err := n.ManagementInterface(test.controllerCard, test.index)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ManagementInterface(%d,%d) got error %v, want error %v", test.controllerCard, test.index, err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("ManagementInterface(%d,%d) got %q, want %q", test.controllerCard, test.index, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPowerSupplyAndFans(t *testing.T) {
+	tests := []struct {
+		desc          string
+		hardwareModel string
+		nameFn        func(*Namer) (string, error)
+		want          string
+		wantErr       bool
+	}{{
+		desc:          "power supply - fixed",
+		hardwareModel: "7220 IXR-D3L",
+		nameFn:        func(n *Namer) (string, error) { return n.PowerSupply(1) },
+		want:          "PowerSupply2",
+	}, {
+		desc:          "power supply - fixed over max",
+		hardwareModel: "7220 IXR-D3L",
+		nameFn:        func(n *Namer) (string, error) { return n.PowerSupply(2) },
+		wantErr:       true,
+	}, {
+		desc:          "power supply - modular",
+		hardwareModel: "7250 IXR-10e",
+		nameFn:        func(n *Namer) (string, error) { return n.PowerSupply(5) },
+		want:          "PowerSupply6",
+	}, {
+		desc:          "fan tray",
+		hardwareModel: "7250 IXR-10e",
+		nameFn:        func(n *Namer) (string, error) { return n.FanTray(2) },
+		want:          "FanTray3",
+	}, {
+		desc:          "fan",
+		hardwareModel: "7250 IXR-10e",
+		nameFn:        func(n *Namer) (string, error) { return n.Fan(2, 5) },
+		want:          "FanTray3-Fan6",
+	}, {
+		desc:          "fan over max",
+		hardwareModel: "7220 IXR-D3L",
+		nameFn:        func(n *Namer) (string, error) { return n.Fan(0, 1) },
+		wantErr:       true,
+	}, {
+		desc:          "fan in invalid tray",
+		hardwareModel: "7250 IXR-10e",
+		nameFn:        func(n *Namer) (string, error) { return n.Fan(3, 0) },
+		wantErr:       true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn(&Namer{HardwareModel: test.hardwareModel})
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestIntegratedCircuitAndCPU(t *testing.T) {
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr bool
+	}{{
+		desc:   "integrated circuit",
+		nameFn: func() (string, error) { return nn.IntegratedCircuit(1, 1) },
+		want:   "Linecard2-Asic1",
+	}, {
+		desc:    "integrated circuit over max",
+		nameFn:  func() (string, error) { return nn.IntegratedCircuit(0, maxIntegratedCircuitIndex+1) },
+		wantErr: true,
+	}, {
+		desc:    "integrated circuit on invalid linecard",
+		nameFn:  func() (string, error) { return nn.IntegratedCircuit(8, 0) },
+		wantErr: true,
+	}, {
+		desc:   "integrated circuit on fixed form factor",
+		nameFn: func() (string, error) { return (&Namer{HardwareModel: "7220 IXR-D3"}).IntegratedCircuit(0, 1) },
+		want:   "Asic1",
+	}, {
+		desc:    "integrated circuit off chassis of fixed form factor",
+		nameFn:  func() (string, error) { return (&Namer{HardwareModel: "7220 IXR-D3"}).IntegratedCircuit(1, 0) },
+		wantErr: true,
+	}, {
+		desc:   "controller card CPU",
+		nameFn: func() (string, error) { return nn.CPU(namer.KindControllerCard, 0, 0) },
+		want:   "Supervisor1-CPU0",
+	}, {
+		desc:   "linecard CPU",
+		nameFn: func() (string, error) { return nn.CPU(namer.KindLinecard, 0, 0) },
+		want:   "Linecard1-CPU0",
+	}, {
+		desc:    "CPU over max",
+		nameFn:  func() (string, error) { return nn.CPU(namer.KindLinecard, 0, maxCPUIndex+1) },
+		wantErr: true,
+	}, {
+		desc:    "CPU on fabric",
+		nameFn:  func() (string, error) { return nn.CPU(namer.KindFabric, 0, 0) },
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestChassisAndBackplane(t *testing.T) {
+	tests := []struct {
+		desc    string
+		nameFn  func() (string, error)
+		want    string
+		wantErr bool
+	}{{
+		desc:   "chassis",
+		nameFn: func() (string, error) { return nn.Chassis(0) },
+		want:   "Chassis",
+	}, {
+		desc:    "chassis over max",
+		nameFn:  func() (string, error) { return nn.Chassis(1) },
+		wantErr: true,
+	}, {
+		desc:    "backplane",
+		nameFn:  func() (string, error) { return nn.Backplane(0) },
+		wantErr: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := test.nameFn()
+			if (err != nil) != test.wantErr {
+				t.Fatalf("got error %v, want error %v", err, test.wantErr)
+			}
+			if got != test.want {
+				t.Errorf("got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseIndex(t *testing.T) {
+	tests := []struct {
+		desc string
+		kind namer.EntityKind
+		name string
+		want uint
+	}{{
+		desc: "PowerSupply1",
+		kind: namer.KindPowerSupply,
+		name: "PowerSupply1",
+		want: 0,
+	}, {
+		desc: "FanTray1",
+		kind: namer.KindFanTray,
+		name: "FanTray1",
+		want: 0,
+	}, {
+		desc: "irb0.100",
+		kind: namer.KindVLANInterface,
+		name: "irb0.100",
+		want: 100,
+	}, {
+		desc: "lo255",
+		kind: namer.KindLoopback,
+		name: "lo255",
+		want: 255,
+	}, {
+		desc: "lag1",
+		kind: namer.KindAggregate,
+		name: "lag1",
+		want: 0,
+	}, {
+		desc: "lag1.0",
+		kind: namer.KindAggregateMember,
+		name: "lag1.0",
+		want: 0,
+	}, {
+		desc: "Linecard8",
+		kind: namer.KindLinecard,
+		name: "Linecard8",
+		want: 7,
+	}, {
+		desc: "Supervisor1",
+		kind: namer.KindControllerCard,
+		name: "Supervisor1",
+		want: 0,
+	}, {
+		desc: "Fabric8",
+		kind: namer.KindFabric,
+		name: "Fabric8",
+		want: 7,
+	}, {
+		desc: "Chassis",
+		kind: namer.KindChassis,
+		name: "Chassis",
+		want: 0,
+	}}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := nn.ParseIndex(test.kind, test.name)
+			if err != nil {
+				t.Fatalf("ParseIndex(%v,%q) got error: %v", test.kind, test.name, err)
+			}
+			if got != test.want {
+				t.Errorf("ParseIndex(%v,%q) got %d, want %d", test.kind, test.name, got, test.want)
+			}
+		})
+	}
+
+	invalidTests := []struct {
+		kind namer.EntityKind
+		name string
+	}{
+		{namer.KindAggregate, "lag0"},
+		{namer.KindAggregateMember, "lag1"},
+		{namer.KindLoopback, "lo256"},
+		{namer.KindControllerCard, "Supervisor3"},
+		{namer.KindChassis, "chassis"},
+	}
+	for _, test := range invalidTests {
+		t.Run("invalid "+test.name, func(t *testing.T) {
+			if _, err := nn.ParseIndex(test.kind, test.name); err == nil {
+				t.Fatalf("ParseIndex(%v,%q) got no error", test.kind, test.name)
+			}
+		})
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	got, err := nn.Capabilities()
+	if err != nil {
+		t.Fatalf("Capabilities() got error: %v", err)
+	}
+	if got.MaxLoopbacks != 256 {
+		t.Errorf("Capabilities() got MaxLoopbacks %d, want 256", got.MaxLoopbacks)
+	}
+	if got.MaxAggregates != 128 {
+		t.Errorf("Capabilities() got MaxAggregates %d, want 128", got.MaxAggregates)
+	}
+	if got.MaxLinecards != 8 {
+		t.Errorf("Capabilities() got MaxLinecards %d, want 8", got.MaxLinecards)
+	}
+	if got.MaxControllerCards != 2 {
+		t.Errorf("Capabilities() got MaxControllerCards %d, want 2", got.MaxControllerCards)
+	}
+	if got.MaxFabrics != 8 {
+		t.Errorf("Capabilities() got MaxFabrics %d, want 8", got.MaxFabrics)
+	}
+	if got.MaxPowerSupplies != 6 {
+		t.Errorf("Capabilities() got MaxPowerSupplies %d, want 6", got.MaxPowerSupplies)
+	}
+	if got.MaxFanTrays != 3 {
+		t.Errorf("Capabilities() got MaxFanTrays %d, want 3", got.MaxFanTrays)
+	}
+
+	fixed := &Namer{HardwareModel: "7220 IXR-D3L"}
+	got, err = fixed.Capabilities()
+	if err != nil {
+		t.Fatalf("Capabilities() of %s got error: %v", fixed.HardwareModel, err)
+	}
+	if got.MaxLinecards != 0 || got.MaxControllerCards != 1 || got.MaxFabrics != 0 {
+		t.Errorf("Capabilities() of %s got %d linecards, %d controller cards, and %d fabrics, want 0, 1, and 0", fixed.HardwareModel, got.MaxLinecards, got.MaxControllerCards, got.MaxFabrics)
+	}
+}
+
+func TestIsFixedFormFactor(t *testing.T) {
+	tests := []struct {
+		hardwareModel string
+		want          bool
+	}{
+		{"7220 IXR-D3L", true},
+		{"7250 IXR-10e", false},
+		{"7220 IXR-D2L", true},
+		{"7220-IXR-D3", true},
+		{"Nokia 7250 IXR-10", false},
+		{"", false},
+		{"unknown", false},
+	}
+	for _, test := range tests {
+		n := &Namer{HardwareModel: test.hardwareModel}
+		if got := n.IsFixedFormFactor(); got != test.want {
+			t.Errorf("IsFixedFormFactor() with hardware model %q got %v, want %v", test.hardwareModel, got, test.want)
+		}
+	}
+}
+
+func TestUnlistedHardwareModel(t *testing.T) {
+	for _, hwm := range []string{"", "7220 IXR-D3L", "7220_IXR-D2L", "Nokia 7250 IXR-10e", "7250 IXR-10"} {
+		n := &Namer{HardwareModel: hwm}
+		if _, err := n.Capabilities(); err != nil {
+			t.Errorf("Capabilities() with hardware model %q got error: %v", hwm, err)
+		}
+	}
+	for _, hwm := range []string{"7750 SR-1", "7220 IXR-H4", "7250 IXR-e"} {
+		n := &Namer{HardwareModel: hwm}
+		if _, err := n.LoopbackInterface(0); err != nil {
+			t.Errorf("LoopbackInterface(0) with hardware model %q got error: %v", hwm, err)
+		}
+		if _, err := n.AggregateInterface(0); err != nil {
+			t.Errorf("AggregateInterface(0) with hardware model %q got error: %v", hwm, err)
+		}
+		if _, err := n.Capabilities(); !errors.Is(err, namer.ErrUnsupportedHardwareModel) {
+			t.Errorf("Capabilities() with hardware model %q got error %v, want %v", hwm, err, namer.ErrUnsupportedHardwareModel)
+		}
+	}
+}
+
+func TestConformance(t *testing.T) {
+	namertest.TestNamer(t, nn)
+	t.Run("fixed form factor", func(t *testing.T) {
+		namertest.TestNamer(t, &Namer{HardwareModel: "7220 IXR-D3L"})
+	})
 }
@@ -0,0 +1,76 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/openconfig/entity-naming/oc"
+)
+
+// Sentinel errors that Namers wrap so that callers can identify the cause of
+// a naming failure with errors.Is.
+var (
+	// ErrUnsupportedHardwareModel indicates that the hardware model of the
+	// device is not supported.
+	ErrUnsupportedHardwareModel = errors.New("unsupported hardware model")
+	// ErrUnsupportedSpeed indicates that the port speed cannot be named.
+	ErrUnsupportedSpeed = errors.New("unsupported port speed")
+	// ErrUnsupportedEntity indicates that the device has no entity of the
+	// requested kind or configuration.
+	ErrUnsupportedEntity = errors.New("unsupported entity")
+)
+
+// IndexOutOfRangeError indicates that an entity index is not valid.
+type IndexOutOfRangeError struct {
+	// Entity is the kind of the entity.
+	Entity EntityKind
+	// Index is the invalid index.
+	Index int
+	// Max is the largest valid index, or -1 if it is not known or the
+	// valid indices are not contiguous.
+	Max int
+}
+
+func (e *IndexOutOfRangeError) Error() string {
+	switch {
+	case e.Index < 0:
+		return fmt.Sprintf("%s index cannot be negative: %d", e.Entity, e.Index)
+	case e.Max >= 0 && e.Index > e.Max:
+		return fmt.Sprintf("%s index cannot exceed %d, got %d", e.Entity, e.Max, e.Index)
+	}
+	return fmt.Sprintf("%s index %d is not valid", e.Entity, e.Index)
+}
+
+// UnsupportedSpeedError indicates that a hardware model has no ports of a
+// speed. It wraps ErrUnsupportedSpeed.
+type UnsupportedSpeedError struct {
+	// Speed is the unsupported ethernet link speed.
+	Speed oc.E_IfEthernet_ETHERNET_SPEED
+	// HardwareModel is the hardware model, or empty if it is not known.
+	HardwareModel string
+}
+
+func (e *UnsupportedSpeedError) Error() string {
+	if e.HardwareModel == "" {
+		return fmt.Sprintf("port speed %v is not supported", e.Speed)
+	}
+	return fmt.Sprintf("port speed %v is not supported by hardware model %q", e.Speed, e.HardwareModel)
+}
+
+func (e *UnsupportedSpeedError) Unwrap() error {
+	return ErrUnsupportedSpeed
+}
@@ -0,0 +1,373 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package namer provides a namer strategy interface for naming entities.
+// Implementations for vendors that are not built into the library can be
+// registered with entname.RegisterVendor.
+package namer
+
+import (
+	"fmt"
+
+	"github.com/openconfig/entity-naming/oc"
+)
+
+// Namer is the core strategy interface for naming entities. It is frozen, so
+// that implementations registered with entname.RegisterVendor keep compiling:
+// further naming capabilities are defined as extension interfaces, which a
+// Namer may also implement and which callers detect with a type assertion.
+type Namer interface {
+	// LoopbackInterface returns the name of the loopback interface with the
+	// specified zero-based index, or an error if no such name exists.
+	LoopbackInterface(index uint) (string, error)
+
+	// AggregateInterface returns the name of the aggregate interface with
+	// the specified zero-based index, or an error if no such name exists.
+	AggregateInterface(index uint) (string, error)
+
+	// AggregateMemberInterface returns the name of the member interface
+	// bound to the aggregate interface with the specified zero-based index,
+	// or an error if no such name exists.
+	AggregateMemberInterface(index uint) (string, error)
+
+	// Linecard returns the name of the linecard component with the specified
+	// zero-based index, or an error if no such name exists.
+	Linecard(index uint) (string, error)
+
+	// ControllerCard returns the name of the controller card component with the
+	// specified zero-based index, or an error if no such name exists.
+	ControllerCard(index uint) (string, error)
+
+	// Fabric returns the name of the fabric component with the specified
+	// zero-based index, or an error if no such name exists.
+	Fabric(index uint) (string, error)
+
+	// Port returns the name of a physical port with the specified parameters,
+	// or an error if no such name exists. This method will never be called with
+	// an unset or unknown port speed.
+	Port(port *PortParams) (string, error)
+
+	// Return whether the device has a fixed form factor.
+	IsFixedFormFactor() bool
+
+	// CommonQoSQueues returns the queue names for the common QoS classes, or an
+	// error if no such names exist.
+	CommonQoSQueues(qos *QoSParams) (*CommonQoSQueueNames, error)
+}
+
+// PortParser is implemented by Namers that can parse port names.
+type PortParser interface {
+	// ParsePort returns the parameters of the physical port with the specified
+	// name, or an error if the name is not a valid port name. Port names do
+	// not encode every parameter, so ParsePort is not a true inverse of Port:
+	//   - Speed is unset if the name does not encode a speed.
+	//   - Breakout is nil if the name does not encode a breakout mode.
+	//   - If the name does not encode the breakout mode of a channel, the
+	//     channel index is that of the channel in the finest breakout the name
+	//     can express, which may differ from the index the name was made from.
+	//   - A name shared by an unchannelized port and one of its channels is
+	//     parsed as the unchannelized port.
+	// Once the caller sets an unset Speed to the speed of the port, Port
+	// returns the name again.
+	ParsePort(name string) (*PortParams, error)
+}
+
+// IndexParser is implemented by Namers that can parse the names of indexed
+// entities.
+type IndexParser interface {
+	// ParseIndex returns the zero-based index of the entity of the specified
+	// kind with the specified name, or an error if the name is not a valid name
+	// of that kind. It is the inverse of the method that names that kind of
+	// entity. This method will never be called with KindPort,
+	// KindSubinterface, KindTunnelInterface, KindManagementInterface,
+	// KindFan, KindIntegratedCircuit, or KindCPU. The index of a backplane is
+	// the index of its chassis.
+	ParseIndex(kind EntityKind, name string) (uint, error)
+}
+
+// HardwareModelValidator is implemented by Namers whose naming depends on the
+// hardware model of the device. Namers that do not implement it are assumed
+// to support every hardware model.
+type HardwareModelValidator interface {
+	// ValidateHardwareModel returns an error if the hardware model of the
+	// device is not supported.
+	ValidateHardwareModel() error
+}
+
+// CapabilitiesReporter is implemented by Namers that know the naming limits
+// of the device.
+type CapabilitiesReporter interface {
+	// Capabilities returns the naming limits of the device, or an error if
+	// they are not known.
+	Capabilities() (*Capabilities, error)
+}
+
+// SubinterfaceNamer is implemented by Namers that can name subinterfaces.
+type SubinterfaceNamer interface {
+	// Subinterface returns the name of the subinterface with the specified
+	// index of the interface with the specified name, or an error if no such
+	// name exists.
+	Subinterface(parent string, index uint) (string, error)
+}
+
+// VLANInterfaceNamer is implemented by Namers that can name routed VLAN
+// interfaces.
+type VLANInterfaceNamer interface {
+	// VLANInterface returns the name of the routed VLAN interface with the
+	// specified VLAN ID, or an error if no such name exists.
+	VLANInterface(vlanID uint) (string, error)
+}
+
+// TunnelInterfaceNamer is implemented by Namers that can name tunnel
+// interfaces.
+type TunnelInterfaceNamer interface {
+	// TunnelInterface returns the name of the tunnel interface with the
+	// specified parameters, or an error if no such name exists.
+	TunnelInterface(tp *TunnelParams) (string, error)
+}
+
+// ManagementInterfaceNamer is implemented by Namers that can name management
+// interfaces.
+type ManagementInterfaceNamer interface {
+	// ManagementInterface returns the name of the management interface with
+	// the specified zero-based index on the controller card with the
+	// specified zero-based index, or an error if no such name exists.
+	ManagementInterface(controllerCardIndex, index uint) (string, error)
+}
+
+// EnvironmentNamer is implemented by Namers that can name power supplies,
+// fan trays, and fans.
+type EnvironmentNamer interface {
+	// PowerSupply returns the name of the power supply component with the
+	// specified zero-based index, or an error if no such name exists.
+	PowerSupply(index uint) (string, error)
+
+	// FanTray returns the name of the fan tray component with the specified
+	// zero-based index, or an error if no such name exists.
+	FanTray(index uint) (string, error)
+
+	// Fan returns the name of the fan component with the specified zero-based
+	// index in the fan tray with the specified zero-based index, or an error if
+	// no such name exists.
+	Fan(trayIndex, fanIndex uint) (string, error)
+}
+
+// ChassisNamer is implemented by Namers that can name chassis and backplanes.
+type ChassisNamer interface {
+	// Chassis returns the name of the chassis component with the specified
+	// zero-based index, or an error if no such name exists.
+	Chassis(index uint) (string, error)
+
+	// Backplane returns the name of the backplane component of the chassis
+	// with the specified zero-based index, or an error if no such name exists.
+	Backplane(chassisIndex uint) (string, error)
+}
+
+// ChipNamer is implemented by Namers that can name integrated circuits and
+// CPUs.
+type ChipNamer interface {
+	// IntegratedCircuit returns the name of the integrated circuit component
+	// with the specified zero-based index on the linecard with the specified
+	// zero-based index, or an error if no such name exists. On a device with
+	// a fixed form factor, linecard index zero refers to the chassis.
+	IntegratedCircuit(linecardIndex, chipIndex uint) (string, error)
+
+	// CPU returns the name of the CPU component with the specified zero-based
+	// index on the card of the specified kind with the specified zero-based
+	// index, or an error if no such name exists. The kind of the card is
+	// KindLinecard or KindControllerCard.
+	CPU(cardKind EntityKind, cardIndex, cpuIndex uint) (string, error)
+}
+
+// TransceiverNamer is implemented by Namers that can name transceivers.
+type TransceiverNamer interface {
+	// Transceiver returns the name of the transceiver component of the
+	// physical port with the specified parameters, or an error if no such
+	// name exists. The channel index and speed of the port are ignored.
+	Transceiver(port *PortParams) (string, error)
+}
+
+// OpticalChannelNamer is implemented by Namers that can name optical channels.
+type OpticalChannelNamer interface {
+	// OpticalChannel returns the name of the optical channel component with
+	// the specified zero-based index of the physical port with the specified
+	// parameters, or an error if no such name exists. The channel index and
+	// speed of the port are ignored.
+	OpticalChannel(port *PortParams, index uint) (string, error)
+}
+
+// LogicalChannelIndexer is implemented by Namers that can index terminal
+// device logical channels.
+type LogicalChannelIndexer interface {
+	// LogicalChannelIndex returns the index of the terminal device logical
+	// channel of the specified kind that carries the physical port with the
+	// specified parameters, or an error if no such index exists. The speed of
+	// the port is ignored.
+	LogicalChannelIndex(port *PortParams, kind LogicalChannelKind) (uint32, error)
+}
+
+// PortASICNamer is implemented by Namers that can map ports to the integrated
+// circuits that serve them.
+type PortASICNamer interface {
+	// PortASIC returns the name of the integrated circuit component that
+	// serves the physical port with the specified parameters, or an error if
+	// no such name exists. The channel index and speed of the port are
+	// ignored.
+	PortASIC(port *PortParams) (string, error)
+}
+
+// EntityKind is a kind of named entity.
+type EntityKind string
+
+// EntityKind enum constants.
+const (
+	KindLoopback            = EntityKind("loopback")
+	KindAggregate           = EntityKind("aggregate")
+	KindAggregateMember     = EntityKind("aggregate member")
+	KindPort                = EntityKind("port")
+	KindLinecard            = EntityKind("linecard")
+	KindControllerCard      = EntityKind("controller card")
+	KindFabric              = EntityKind("fabric")
+	KindSubinterface        = EntityKind("subinterface")
+	KindVLANInterface       = EntityKind("VLAN interface")
+	KindTunnelInterface     = EntityKind("tunnel interface")
+	KindManagementInterface = EntityKind("management interface")
+	KindPowerSupply         = EntityKind("power supply")
+	KindFanTray             = EntityKind("fan tray")
+	KindFan                 = EntityKind("fan")
+	KindChassis             = EntityKind("chassis")
+	KindBackplane           = EntityKind("backplane")
+	KindIntegratedCircuit   = EntityKind("integrated circuit")
+	KindCPU                 = EntityKind("CPU")
+	KindOpticalChannel      = EntityKind("optical channel")
+	// KindSlot, KindPIC, and KindChannel are not named entities. They
+	// identify the indices of a port in an IndexOutOfRangeError.
+	KindSlot    = EntityKind("slot")
+	KindPIC     = EntityKind("PIC")
+	KindChannel = EntityKind("channel")
+)
+
+// TunnelKind is a kind of tunnel.
+type TunnelKind string
+
+// TunnelKind enum constants.
+const (
+	TunnelGRE    = TunnelKind("GRE")
+	TunnelIPinIP = TunnelKind("IP-in-IP")
+	TunnelMPLSTE = TunnelKind("MPLS-TE")
+)
+
+// TunnelParams are parameters of a tunnel interface.
+type TunnelParams struct {
+	// Kind is the kind of the tunnel.
+	Kind TunnelKind
+	// Index is the zero-based index of the tunnel.
+	Index uint
+	// LinecardIndex is the zero-based index of the linecard hosting the
+	// tunnel service. It is ignored by vendors whose tunnel names do not
+	// depend on the linecard.
+	LinecardIndex uint
+}
+
+func (tp *TunnelParams) String() string {
+	return fmt.Sprintf("%+v", *tp)
+}
+
+// LogicalChannelKind is a kind of terminal device logical channel.
+type LogicalChannelKind string
+
+// LogicalChannelKind enum constants.
+const (
+	LogicalChannelEthernet = LogicalChannelKind("ethernet")
+	LogicalChannelOTN      = LogicalChannelKind("OTN")
+	LogicalChannelCoherent = LogicalChannelKind("coherent")
+)
+
+// PortParams are parameters of a network port.
+type PortParams struct {
+	// SlotIndex is the zero-based index of the slot on the device.
+	// This value is nil on fixed form factor devices.
+	SlotIndex *uint
+	// PICIndex is the zero-based index of the PIC within the slot.
+	PICIndex uint
+	// PortIndex is the zero-based index of the port within the PIC.
+	PortIndex uint
+	// ChannelIndex is the zero-based index of the channel within the Port.
+	// This value is nil for unchannelized ports.
+	ChannelIndex *uint
+	// Channelizable indicates whether the port can be channelized.
+	Channelizable bool
+	// Speed is the ethernet link speed of the port.
+	Speed oc.E_IfEthernet_ETHERNET_SPEED
+	// Breakout is the breakout mode of a channelized port.
+	// This value is nil if the breakout mode is not known.
+	Breakout *BreakoutMode
+}
+
+func (pp *PortParams) String() string {
+	return fmt.Sprintf("%+v", *pp)
+}
+
+// ChannelSpeed returns the ethernet link speed of the channel of the port:
+// the channel speed of the breakout mode if the port is channelized and the
+// channel speed is known, or else the speed of the port.
+func (pp *PortParams) ChannelSpeed() oc.E_IfEthernet_ETHERNET_SPEED {
+	if pp.ChannelIndex != nil && pp.Breakout != nil && pp.Breakout.ChannelSpeed != oc.IfEthernet_ETHERNET_SPEED_UNSET {
+		return pp.Breakout.ChannelSpeed
+	}
+	return pp.Speed
+}
+
+// BreakoutMode is the breakout mode of a channelized port.
+type BreakoutMode struct {
+	// NumChannels is the number of channels of the port, or zero if it is not
+	// known.
+	NumChannels uint
+	// ChannelSpeed is the ethernet link speed of each channel of the port.
+	ChannelSpeed oc.E_IfEthernet_ETHERNET_SPEED
+}
+
+func (bm *BreakoutMode) String() string {
+	return fmt.Sprintf("%+v", *bm)
+}
+
+// Capabilities are the naming limits of a device.
+type Capabilities struct {
+	// MaxLoopbacks, MaxAggregates, MaxLinecards, MaxControllerCards,
+	// MaxFabrics, MaxPowerSupplies, and MaxFanTrays are the maximum numbers of
+	// each kind of entity. On devices that span multiple chassis, they are the
+	// maximum numbers per chassis.
+	MaxLoopbacks, MaxAggregates, MaxLinecards, MaxControllerCards, MaxFabrics uint
+	MaxPowerSupplies, MaxFanTrays                                             uint
+	// PortSpeeds are the ethernet link speeds of the ports that can be named.
+	PortSpeeds []oc.E_IfEthernet_ETHERNET_SPEED
+}
+
+func (c *Capabilities) String() string {
+	return fmt.Sprintf("%+v", *c)
+}
+
+// QoSParams are parameters of a QoS configuration.
+type QoSParams struct {
+	NumStrictPriority, NumWeightedRoundRobin uint
+}
+
+// CommonQoSQueueNames are the queue names for the common QoS classes.
+type CommonQoSQueueNames struct {
+	NC1, AF4, AF3, AF2, AF1, BE1, BE0 string
+}
+
+func (qn *CommonQoSQueueNames) String() string {
+	return fmt.Sprintf("%+v", *qn)
+}
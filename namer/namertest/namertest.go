@@ -0,0 +1,672 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package namertest provides a conformance test suite for implementations of
+// the namer.Namer interface.
+package namertest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/openconfig/entity-naming/namer"
+	"github.com/openconfig/entity-naming/oc"
+)
+
+const (
+	// maxProbeIndex is the largest index probed for each indexed entity.
+	maxProbeIndex = 1023
+	// maxProbeSlot, maxProbePort and maxProbeChannel bound the port space probed.
+	maxProbeSlot, maxProbePort, maxProbeChannel = 3, 7, 3
+)
+
+// indexedKind is a kind of indexed entity and the method that names it.
+type indexedKind struct {
+	kind   namer.EntityKind
+	nameFn func(uint) (string, error)
+}
+
+// TestNamer checks that the Namer satisfies the invariants that every Namer
+// must satisfy, reporting each violation as a test failure. The invariants of
+// each extension interface are checked only if the Namer implements it.
+func TestNamer(t *testing.T, n namer.Namer) {
+	t.Helper()
+	indexedKinds := []indexedKind{
+		{namer.KindLoopback, n.LoopbackInterface},
+		{namer.KindAggregate, n.AggregateInterface},
+		{namer.KindAggregateMember, n.AggregateMemberInterface},
+		{namer.KindLinecard, n.Linecard},
+		{namer.KindControllerCard, n.ControllerCard},
+		{namer.KindFabric, n.Fabric},
+	}
+	if vn, ok := n.(namer.VLANInterfaceNamer); ok {
+		indexedKinds = append(indexedKinds, indexedKind{namer.KindVLANInterface, vn.VLANInterface})
+	}
+	if en, ok := n.(namer.EnvironmentNamer); ok {
+		indexedKinds = append(indexedKinds,
+			indexedKind{namer.KindPowerSupply, en.PowerSupply},
+			indexedKind{namer.KindFanTray, en.FanTray})
+	}
+	if cn, ok := n.(namer.ChassisNamer); ok {
+		indexedKinds = append(indexedKinds,
+			indexedKind{namer.KindChassis, cn.Chassis},
+			indexedKind{namer.KindBackplane, cn.Backplane})
+	}
+	for _, ik := range indexedKinds {
+		t.Run(string(ik.kind), func(t *testing.T) {
+			testIndexed(t, n, ik.kind, ik.nameFn)
+		})
+	}
+	t.Run("aggregate member", func(t *testing.T) {
+		testAggregateMember(t, n)
+	})
+	t.Run("port", func(t *testing.T) {
+		testPort(t, n)
+	})
+	if sn, ok := n.(namer.SubinterfaceNamer); ok {
+		t.Run("subinterface", func(t *testing.T) {
+			testSubinterface(t, n, sn)
+		})
+	}
+	if tn, ok := n.(namer.TunnelInterfaceNamer); ok {
+		t.Run("tunnel interface", func(t *testing.T) {
+			testTunnelInterface(t, tn)
+		})
+	}
+	if en, ok := n.(namer.EnvironmentNamer); ok {
+		t.Run("fan", func(t *testing.T) {
+			testFan(t, en)
+		})
+	}
+	if cn, ok := n.(namer.ChipNamer); ok {
+		t.Run("integrated circuit", func(t *testing.T) {
+			testCardComponent(t, namer.KindIntegratedCircuit, namer.KindLinecard, chipCardFn(n), cn.IntegratedCircuit)
+		})
+		t.Run("linecard CPU", func(t *testing.T) {
+			testCardComponent(t, namer.KindCPU, namer.KindLinecard, n.Linecard, func(card, index uint) (string, error) {
+				return cn.CPU(namer.KindLinecard, card, index)
+			})
+		})
+		t.Run("controller card CPU", func(t *testing.T) {
+			testCardComponent(t, namer.KindCPU, namer.KindControllerCard, n.ControllerCard, func(card, index uint) (string, error) {
+				return cn.CPU(namer.KindControllerCard, card, index)
+			})
+		})
+	}
+	if mn, ok := n.(namer.ManagementInterfaceNamer); ok {
+		t.Run("management interface", func(t *testing.T) {
+			testManagementInterface(t, n, mn)
+		})
+	}
+	if cr, ok := n.(namer.CapabilitiesReporter); ok {
+		t.Run("capabilities", func(t *testing.T) {
+			testCapabilities(t, n, cr)
+		})
+	}
+	t.Run("common qos queues", func(t *testing.T) {
+		testCommonQoSQueues(t, n)
+	})
+}
+
+// testIndexed checks that the names of an indexed entity are unique, that
+// exactly one of a name and an error is returned for each index, that the
+// same index always has the same outcome, and that ParseIndex, if implemented,
+// is the inverse of the naming method.
+func testIndexed(t *testing.T, n namer.Namer, kind namer.EntityKind, nameFn func(uint) (string, error)) {
+	ip, canParse := n.(namer.IndexParser)
+	indexByName := make(map[string]uint)
+	for index := uint(0); index <= maxProbeIndex; index++ {
+		name, ok := checkResult(t, fmt.Sprintf("%s(%d)", kind, index), nameFn, index)
+		if !ok {
+			continue
+		}
+		if prev, ok := indexByName[name]; ok {
+			t.Errorf("%s indices %d and %d both have name %q", kind, prev, index, name)
+			continue
+		}
+		indexByName[name] = index
+		if !canParse {
+			continue
+		}
+		got, err := ip.ParseIndex(kind, name)
+		if err != nil {
+			t.Errorf("ParseIndex(%v,%q) got error: %v", kind, name, err)
+		} else if got != index {
+			t.Errorf("ParseIndex(%v,%q) got %d, want %d", kind, name, got, index)
+		}
+	}
+	if len(indexByName) == 0 {
+		t.Logf("no valid %s index in [0,%d]", kind, maxProbeIndex)
+	}
+}
+
+// checkResult calls the naming function with the index twice and checks that
+// it returns exactly one of a name and an error, and the same result on both
+// calls. It returns the name and whether the call was successful.
+func checkResult(t *testing.T, desc string, nameFn func(uint) (string, error), index uint) (string, bool) {
+	t.Helper()
+	name, err := nameFn(index)
+	again, againErr := nameFn(index)
+	if name != again || (err == nil) != (againErr == nil) {
+		t.Errorf("%s is not deterministic: got (%q, %v) then (%q, %v)", desc, name, err, again, againErr)
+	}
+	switch {
+	case err != nil && name != "":
+		t.Errorf("%s got both name %q and error %v", desc, name, err)
+	case err == nil && name == "":
+		t.Errorf("%s got neither a name nor an error", desc)
+	}
+	return name, err == nil
+}
+
+// testAggregateMember checks that every aggregate member is named if and only
+// if its aggregate is, and that its name is either the name of the aggregate
+// or the name of a subinterface of the aggregate.
+func testAggregateMember(t *testing.T, n namer.Namer) {
+	for index := uint(0); index <= maxProbeIndex; index++ {
+		agg, aggErr := n.AggregateInterface(index)
+		member, memberErr := n.AggregateMemberInterface(index)
+		if (aggErr == nil) != (memberErr == nil) {
+			t.Errorf("AggregateInterface(%d) got error %v, but AggregateMemberInterface(%d) got error %v", index, aggErr, index, memberErr)
+			continue
+		}
+		if aggErr != nil {
+			continue
+		}
+		if member != agg && !isSubinterfaceOf(member, agg) {
+			t.Errorf("AggregateMemberInterface(%d) got %q, want %q or a subinterface of it", index, member, agg)
+		}
+	}
+}
+
+func isSubinterfaceOf(name, parent string) bool {
+	return len(name) > len(parent)+1 && name[:len(parent)+1] == parent+"."
+}
+
+// testSubinterface checks that the subinterfaces of a port have distinct
+// names that are subinterfaces of the name of the port.
+func testSubinterface(t *testing.T, n namer.Namer, sn namer.SubinterfaceNamer) {
+	parent, err := n.Port(portParams(n.IsFixedFormFactor(), 0, 0)[1])
+	if err != nil {
+		t.Skipf("cannot name a parent port: %v", err)
+	}
+	nameFn := func(index uint) (string, error) { return sn.Subinterface(parent, index) }
+	indexByName := make(map[string]uint)
+	for index := uint(0); index <= maxProbeIndex; index++ {
+		name, ok := checkResult(t, fmt.Sprintf("Subinterface(%q,%d)", parent, index), nameFn, index)
+		if !ok {
+			continue
+		}
+		if !isSubinterfaceOf(name, parent) {
+			t.Errorf("Subinterface(%q,%d) got %q, want a subinterface of %q", parent, index, name, parent)
+		}
+		if prev, ok := indexByName[name]; ok {
+			t.Errorf("subinterfaces %d and %d of %q both have name %q", prev, index, parent, name)
+		}
+		indexByName[name] = index
+	}
+}
+
+// testTunnelInterface checks that the tunnel interfaces of each kind hosted by
+// the same linecard have distinct names.
+func testTunnelInterface(t *testing.T, tn namer.TunnelInterfaceNamer) {
+	for _, kind := range []namer.TunnelKind{namer.TunnelGRE, namer.TunnelIPinIP, namer.TunnelMPLSTE} {
+		nameFn := func(index uint) (string, error) {
+			return tn.TunnelInterface(&namer.TunnelParams{Kind: kind, Index: index})
+		}
+		indexByName := make(map[string]uint)
+		for index := uint(0); index <= maxProbeIndex; index++ {
+			name, ok := checkResult(t, fmt.Sprintf("%s TunnelInterface(%d)", kind, index), nameFn, index)
+			if !ok {
+				continue
+			}
+			if prev, ok := indexByName[name]; ok {
+				t.Errorf("%s tunnels %d and %d both have name %q", kind, prev, index, name)
+			}
+			indexByName[name] = index
+		}
+	}
+}
+
+// portKey identifies a physical port on a device.
+type portKey struct {
+	slot, port uint
+}
+
+// channelName is the name of a channel of a port with a breakout mode.
+type channelName struct {
+	name     string
+	breakout namer.BreakoutMode
+}
+
+// testPort checks that distinct physical ports and distinct channels of the
+// same port have distinct names, that ParsePort is the inverse of Port, and
+// that the channels of a port share its transceiver, integrated circuit, and
+// optical channels, and that distinct logical channels have distinct indices.
+// Each of these checks is made only if the Namer implements its extension.
+// Names may be shared by different channel configurations of the same port,
+// such as an unchannelized port and its first channel, or channels of
+// different breakout modes.
+func testPort(t *testing.T, n namer.Namer) {
+	fixedFormFactor := n.IsFixedFormFactor()
+	if again := n.IsFixedFormFactor(); again != fixedFormFactor {
+		t.Errorf("IsFixedFormFactor() is not deterministic: got %v then %v", fixedFormFactor, again)
+	}
+	maxSlot := uint(maxProbeSlot)
+	if fixedFormFactor {
+		maxSlot = 0
+	}
+	portByName := make(map[string]portKey)
+	xcvrByName := make(map[string]portKey)
+	ochByName := make(map[string]portKey)
+	lcByIndex := make(map[uint32]logicalChannelKey)
+	for slot := uint(0); slot <= maxSlot; slot++ {
+		for port := uint(0); port <= maxProbePort; port++ {
+			key := portKey{slot: slot, port: port}
+			// channelNames maps the names of the channels of each breakout
+			// mode to their channel indices.
+			channelNames := make(map[channelName]uint)
+			for _, pp := range portParams(fixedFormFactor, slot, port) {
+				name, err := n.Port(pp)
+				if err != nil {
+					if name != "" {
+						t.Errorf("Port(%v) got both name %q and error %v", pp, name, err)
+					}
+					continue
+				}
+				if name == "" {
+					t.Errorf("Port(%v) got neither a name nor an error", pp)
+					continue
+				}
+				if prev, ok := portByName[name]; ok && prev != key {
+					t.Errorf("ports %+v and %+v both have name %q", prev, key, name)
+				}
+				portByName[name] = key
+				if pp.ChannelIndex != nil {
+					cn := channelName{name: name}
+					if pp.Breakout != nil {
+						cn.breakout = *pp.Breakout
+					}
+					if prev, ok := channelNames[cn]; ok && prev != *pp.ChannelIndex {
+						t.Errorf("channels %d and %d of port %+v both have name %q", prev, *pp.ChannelIndex, key, name)
+					}
+					channelNames[cn] = *pp.ChannelIndex
+				}
+				checkParsePort(t, n, pp, name)
+				checkTransceiver(t, n, pp, key, xcvrByName)
+				checkPortASIC(t, n, pp)
+				checkOpticalChannels(t, n, pp, key, ochByName)
+				checkLogicalChannelIndex(t, n, pp, key, lcByIndex)
+			}
+		}
+	}
+}
+
+// checkTransceiver checks that every channel of a port has the same
+// transceiver and that distinct ports have distinct transceivers.
+func checkTransceiver(t *testing.T, n namer.Namer, pp *namer.PortParams, key portKey, xcvrByName map[string]portKey) {
+	t.Helper()
+	xn, ok := n.(namer.TransceiverNamer)
+	if !ok {
+		return
+	}
+	name, err := xn.Transceiver(pp)
+	if errors.Is(err, namer.ErrUnsupportedEntity) {
+		return
+	}
+	if err != nil {
+		t.Errorf("Transceiver(%v) got error: %v", pp, err)
+		return
+	}
+	unchannelized := *pp
+	unchannelized.ChannelIndex = nil
+	if want, err := xn.Transceiver(&unchannelized); err != nil || name != want {
+		t.Errorf("Transceiver(%v) got %q, want %q, the transceiver of %v (error: %v)", pp, name, want, &unchannelized, err)
+	}
+	if prev, ok := xcvrByName[name]; ok && prev != key {
+		t.Errorf("ports %+v and %+v both have transceiver %q", prev, key, name)
+	}
+	xcvrByName[name] = key
+}
+
+// checkPortASIC checks that every channel of a port is served by the same
+// integrated circuit.
+func checkPortASIC(t *testing.T, n namer.Namer, pp *namer.PortParams) {
+	t.Helper()
+	an, ok := n.(namer.PortASICNamer)
+	if !ok {
+		return
+	}
+	name, err := an.PortASIC(pp)
+	if errors.Is(err, namer.ErrUnsupportedEntity) {
+		return
+	}
+	if err != nil {
+		t.Errorf("PortASIC(%v) got error: %v", pp, err)
+		return
+	}
+	unchannelized := *pp
+	unchannelized.ChannelIndex = nil
+	if want, err := an.PortASIC(&unchannelized); err != nil || name != want {
+		t.Errorf("PortASIC(%v) got %q, want %q, the integrated circuit of %v (error: %v)", pp, name, want, &unchannelized, err)
+	}
+}
+
+// checkOpticalChannels checks that every channel of a port has the same
+// optical channels, and that distinct optical channels have distinct names.
+func checkOpticalChannels(t *testing.T, n namer.Namer, pp *namer.PortParams, key portKey, ochByName map[string]portKey) {
+	t.Helper()
+	on, ok := n.(namer.OpticalChannelNamer)
+	if !ok {
+		return
+	}
+	unchannelized := *pp
+	unchannelized.ChannelIndex = nil
+	indexByName := make(map[string]uint)
+	for index := uint(0); index <= maxProbeChannel; index++ {
+		name, err := on.OpticalChannel(pp, index)
+		if errors.Is(err, namer.ErrUnsupportedEntity) {
+			return
+		}
+		if err != nil {
+			continue
+		}
+		if want, err := on.OpticalChannel(&unchannelized, index); err != nil || name != want {
+			t.Errorf("OpticalChannel(%v,%d) got %q, want %q, the optical channel of %v (error: %v)", pp, index, name, want, &unchannelized, err)
+		}
+		if prev, ok := indexByName[name]; ok && prev != index {
+			t.Errorf("optical channels %d and %d of port %+v both have name %q", prev, index, key, name)
+		}
+		indexByName[name] = index
+		if prev, ok := ochByName[name]; ok && prev != key {
+			t.Errorf("ports %+v and %+v both have optical channel %q", prev, key, name)
+		}
+		ochByName[name] = key
+	}
+}
+
+// logicalChannelKey identifies a terminal device logical channel of a port.
+type logicalChannelKey struct {
+	port portKey
+	// channel is the channel index of the port, or -1 if it is unchannelized.
+	channel int
+	kind    namer.LogicalChannelKind
+}
+
+// checkLogicalChannelIndex checks that distinct logical channels have
+// distinct indices.
+func checkLogicalChannelIndex(t *testing.T, n namer.Namer, pp *namer.PortParams, key portKey, lcByIndex map[uint32]logicalChannelKey) {
+	t.Helper()
+	li, ok := n.(namer.LogicalChannelIndexer)
+	if !ok {
+		return
+	}
+	channel := -1
+	if pp.ChannelIndex != nil {
+		channel = int(*pp.ChannelIndex)
+	}
+	for _, kind := range []namer.LogicalChannelKind{namer.LogicalChannelEthernet, namer.LogicalChannelOTN, namer.LogicalChannelCoherent} {
+		index, err := li.LogicalChannelIndex(pp, kind)
+		if err != nil {
+			continue
+		}
+		lk := logicalChannelKey{port: key, channel: channel, kind: kind}
+		if prev, ok := lcByIndex[index]; ok && prev != lk {
+			t.Errorf("logical channels %+v and %+v both have index %d", prev, lk, index)
+		}
+		lcByIndex[index] = lk
+	}
+}
+
+// probeSpeeds are the port speeds probed, each with the channel speed of a
+// breakout of the port into maxProbeChannel+1 channels.
+var probeSpeeds = []struct {
+	port, channel oc.E_IfEthernet_ETHERNET_SPEED
+}{
+	{oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB, oc.IfEthernet_ETHERNET_SPEED_SPEED_10GB},
+	{oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB, oc.IfEthernet_ETHERNET_SPEED_SPEED_25GB},
+	{oc.IfEthernet_ETHERNET_SPEED_SPEED_400GB, oc.IfEthernet_ETHERNET_SPEED_SPEED_100GB},
+}
+
+// portParams returns the parameters of every channel configuration of a port
+// at every probed speed. The first two are the unchannelizable and
+// channelizable unchannelized port at the first probed speed.
+func portParams(fixedFormFactor bool, slot, port uint) []*namer.PortParams {
+	var pps []*namer.PortParams
+	for _, speeds := range probeSpeeds {
+		newParams := func(channelizable bool) *namer.PortParams {
+			pp := &namer.PortParams{
+				PortIndex:     port,
+				Channelizable: channelizable,
+				Speed:         speeds.port,
+			}
+			if !fixedFormFactor {
+				slotIndex := slot
+				pp.SlotIndex = &slotIndex
+			}
+			return pp
+		}
+		pps = append(pps, newParams(false), newParams(true))
+		for channel := uint(0); channel <= maxProbeChannel; channel++ {
+			pp := newParams(true)
+			channelIndex := channel
+			pp.ChannelIndex = &channelIndex
+			broken := *pp
+			broken.Breakout = &namer.BreakoutMode{
+				NumChannels:  maxProbeChannel + 1,
+				ChannelSpeed: speeds.channel,
+			}
+			pps = append(pps, pp, &broken)
+		}
+	}
+	return pps
+}
+
+// checkParsePort checks that parsing the name of a port yields parameters
+// with the same name and the same slot and port indices, once an unset speed
+// is set to the speed of the port, as PortParser documents.
+func checkParsePort(t *testing.T, n namer.Namer, pp *namer.PortParams, name string) {
+	t.Helper()
+	pn, ok := n.(namer.PortParser)
+	if !ok {
+		return
+	}
+	parsed, err := pn.ParsePort(name)
+	if err != nil {
+		t.Errorf("ParsePort(%q) got error: %v", name, err)
+		return
+	}
+	if (parsed.SlotIndex == nil) != (pp.SlotIndex == nil) ||
+		(parsed.SlotIndex != nil && *parsed.SlotIndex != *pp.SlotIndex) ||
+		parsed.PortIndex != pp.PortIndex {
+		t.Errorf("ParsePort(%q) got %v, want the slot and port of %v", name, parsed, pp)
+	}
+	if parsed.Speed == oc.IfEthernet_ETHERNET_SPEED_UNSET {
+		parsed.Speed = pp.Speed
+	}
+	got, err := n.Port(parsed)
+	if err != nil {
+		t.Errorf("Port(ParsePort(%q)) got error: %v", name, err)
+		return
+	}
+	if got != name {
+		t.Errorf("Port(ParsePort(%q)) got %q, want %q", name, got, name)
+	}
+}
+
+// testFan checks that the fans of every fan tray have distinct names, and
+// that no fan is named in a fan tray that cannot be named.
+func testFan(t *testing.T, en namer.EnvironmentNamer) {
+	fanByName := make(map[string][2]uint)
+	for tray := uint(0); tray <= maxProbePort; tray++ {
+		nameFn := func(index uint) (string, error) { return en.Fan(tray, index) }
+		_, trayErr := en.FanTray(tray)
+		for index := uint(0); index <= maxProbePort; index++ {
+			name, ok := checkResult(t, fmt.Sprintf("Fan(%d,%d)", tray, index), nameFn, index)
+			if !ok {
+				continue
+			}
+			if trayErr != nil {
+				t.Errorf("Fan(%d,%d) got %q, but FanTray(%d) got error %v", tray, index, name, tray, trayErr)
+			}
+			if prev, ok := fanByName[name]; ok {
+				t.Errorf("fans %v and %v both have name %q", prev, [2]uint{tray, index}, name)
+			}
+			fanByName[name] = [2]uint{tray, index}
+		}
+	}
+}
+
+// testCardComponent checks that the components of a kind on cards of a kind
+// have distinct names, and that no component is named on a card that cannot
+// be named.
+func testCardComponent(t *testing.T, kind, cardKind namer.EntityKind, cardFn func(uint) (string, error), nameFn func(uint, uint) (string, error)) {
+	byName := make(map[string][2]uint)
+	for card := uint(0); card <= maxProbeSlot; card++ {
+		indexFn := func(index uint) (string, error) { return nameFn(card, index) }
+		_, cardErr := cardFn(card)
+		for index := uint(0); index <= maxProbePort; index++ {
+			name, ok := checkResult(t, fmt.Sprintf("%s(%d,%d)", kind, card, index), indexFn, index)
+			if !ok {
+				continue
+			}
+			if cardErr != nil {
+				t.Errorf("%s(%d,%d) got %q, but %s(%d) got error %v", kind, card, index, name, cardKind, card, cardErr)
+			}
+			if prev, ok := byName[name]; ok {
+				t.Errorf("%ss %v and %v both have name %q", kind, prev, [2]uint{card, index}, name)
+			}
+			byName[name] = [2]uint{card, index}
+		}
+	}
+}
+
+// chipCardFn returns the function that names the cards that integrated
+// circuits can be on. On a device with a fixed form factor, the chassis is
+// card zero whether or not the device has linecards.
+func chipCardFn(n namer.Namer) func(uint) (string, error) {
+	if !n.IsFixedFormFactor() {
+		return n.Linecard
+	}
+	return func(index uint) (string, error) {
+		if index == 0 {
+			return "chassis", nil
+		}
+		return n.Linecard(index)
+	}
+}
+
+// testManagementInterface checks that the management interfaces of a
+// controller card have distinct names, and that no management interface is
+// named on a controller card that cannot be named.
+func testManagementInterface(t *testing.T, n namer.Namer, mn namer.ManagementInterfaceNamer) {
+	for cc := uint(0); cc <= maxProbeSlot; cc++ {
+		nameFn := func(index uint) (string, error) { return mn.ManagementInterface(cc, index) }
+		_, ccErr := n.ControllerCard(cc)
+		indexByName := make(map[string]uint)
+		for index := uint(0); index <= maxProbePort; index++ {
+			name, ok := checkResult(t, fmt.Sprintf("ManagementInterface(%d,%d)", cc, index), nameFn, index)
+			if !ok {
+				continue
+			}
+			if ccErr != nil {
+				t.Errorf("ManagementInterface(%d,%d) got %q, but ControllerCard(%d) got error %v", cc, index, name, cc, ccErr)
+			}
+			if prev, ok := indexByName[name]; ok {
+				t.Errorf("management interfaces %d and %d of controller card %d both have name %q", prev, index, cc, name)
+			}
+			indexByName[name] = index
+		}
+	}
+}
+
+// kindCapability is the maximum number of a kind of entity and the method
+// that names it.
+type kindCapability struct {
+	kind   namer.EntityKind
+	max    uint
+	nameFn func(uint) (string, error)
+}
+
+// testCapabilities checks that each kind of entity that the capabilities
+// permit has a valid index, that each kind they forbid has none, and that
+// ports of every supported speed can be named.
+func testCapabilities(t *testing.T, n namer.Namer, cr namer.CapabilitiesReporter) {
+	caps, err := cr.Capabilities()
+	if err != nil {
+		t.Fatalf("Capabilities() got error: %v", err)
+	}
+	kindCaps := []kindCapability{
+		{namer.KindLoopback, caps.MaxLoopbacks, n.LoopbackInterface},
+		{namer.KindAggregate, caps.MaxAggregates, n.AggregateInterface},
+		{namer.KindLinecard, caps.MaxLinecards, n.Linecard},
+		{namer.KindControllerCard, caps.MaxControllerCards, n.ControllerCard},
+		{namer.KindFabric, caps.MaxFabrics, n.Fabric},
+	}
+	if en, ok := n.(namer.EnvironmentNamer); ok {
+		kindCaps = append(kindCaps,
+			kindCapability{namer.KindPowerSupply, caps.MaxPowerSupplies, en.PowerSupply},
+			kindCapability{namer.KindFanTray, caps.MaxFanTrays, en.FanTray})
+	}
+	for _, kc := range kindCaps {
+		var valid bool
+		for index := uint(0); index <= maxProbeIndex && !valid; index++ {
+			_, err := kc.nameFn(index)
+			valid = err == nil
+		}
+		if valid != (kc.max > 0) {
+			t.Errorf("Capabilities() got max %d for %s, but a valid index in [0,%d] exists: %v", kc.max, kc.kind, maxProbeIndex, valid)
+		}
+	}
+	if len(caps.PortSpeeds) == 0 {
+		t.Errorf("Capabilities() got no port speeds")
+	}
+	for _, speed := range caps.PortSpeeds {
+		pp := portParams(n.IsFixedFormFactor(), 0, 0)[1]
+		pp.Speed = speed
+		if _, err := n.Port(pp); err != nil {
+			t.Errorf("Port(%v) got error for a supported speed: %v", pp, err)
+		}
+	}
+}
+
+// testCommonQoSQueues checks that the common QoS queue names are non-empty
+// and distinct.
+func testCommonQoSQueues(t *testing.T, n namer.Namer) {
+	qos := &namer.QoSParams{}
+	qn, err := n.CommonQoSQueues(qos)
+	if err != nil {
+		t.Fatalf("CommonQoSQueues(%v) got error: %v", qos, err)
+	}
+	classByName := make(map[string]string)
+	for _, q := range []struct{ class, name string }{
+		{"NC1", qn.NC1},
+		{"AF4", qn.AF4},
+		{"AF3", qn.AF3},
+		{"AF2", qn.AF2},
+		{"AF1", qn.AF1},
+		{"BE1", qn.BE1},
+		{"BE0", qn.BE0},
+	} {
+		if q.name == "" {
+			t.Errorf("CommonQoSQueues(%v) got empty name for class %s", qos, q.class)
+			continue
+		}
+		if prev, ok := classByName[q.name]; ok {
+			t.Errorf("CommonQoSQueues(%v) got name %q for both classes %s and %s", qos, q.name, prev, q.class)
+		}
+		classByName[q.name] = q.class
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2023 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namertest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/entity-naming/namer"
+)
+
+// brokenEnv is set in the environment of the subprocess that runs the suite
+// against brokenNamer.
+const brokenEnv = "NAMERTEST_BROKEN"
+
+func TestGoodNamer(t *testing.T) {
+	TestNamer(t, goodNamer{})
+}
+
+func TestBrokenNamer(t *testing.T) {
+	if os.Getenv(brokenEnv) != "" {
+		TestNamer(t, brokenNamer{})
+		return
+	}
+	// The suite reports violations as failures of the test that runs it, so
+	// run it in a subprocess and check that it fails for the right reasons.
+	cmd := exec.Command(os.Args[0], "-test.run=^TestBrokenNamer$")
+	cmd.Env = append(os.Environ(), brokenEnv+"=1")
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("TestNamer(brokenNamer) passed, want failures")
+	}
+	for _, want := range []string{
+		`fabric(0) got both name "Fabric0" and error`,
+		`both have name "Ethernet0/0"`,
+		`both have name "Ethernet0/0/1"`,
+		`got name "BE" for both classes BE1 and BE0`,
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("TestNamer(brokenNamer) output does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+// goodNamer is a modular Namer that implements only the core interface and
+// satisfies every invariant of the suite.
+type goodNamer struct{}
+
+func (goodNamer) LoopbackInterface(index uint) (string, error) {
+	return fmt.Sprintf("Loopback%d", index), nil
+}
+
+func (goodNamer) AggregateInterface(index uint) (string, error) {
+	return fmt.Sprintf("Aggregate%d", index+1), nil
+}
+
+func (n goodNamer) AggregateMemberInterface(index uint) (string, error) {
+	return n.AggregateInterface(index)
+}
+
+func (goodNamer) Linecard(index uint) (string, error) {
+	return fmt.Sprintf("Linecard%d", index), nil
+}
+
+func (goodNamer) ControllerCard(index uint) (string, error) {
+	if index > 1 {
+		return "", errors.New("controller card index out of range")
+	}
+	return fmt.Sprintf("Supervisor%d", index), nil
+}
+
+func (goodNamer) Fabric(index uint) (string, error) {
+	return fmt.Sprintf("Fabric%d", index), nil
+}
+
+func (goodNamer) Port(pp *namer.PortParams) (string, error) {
+	name := fmt.Sprintf("Ethernet%d/%d", *pp.SlotIndex, pp.PortIndex)
+	if pp.ChannelIndex != nil {
+		name += fmt.Sprintf("/%d", *pp.ChannelIndex+1)
+	}
+	return name, nil
+}
+
+func (goodNamer) IsFixedFormFactor() bool {
+	return false
+}
+
+func (goodNamer) CommonQoSQueues(*namer.QoSParams) (*namer.CommonQoSQueueNames, error) {
+	return &namer.CommonQoSQueueNames{
+		NC1: "NC1", AF4: "AF4", AF3: "AF3", AF2: "AF2", AF1: "AF1", BE1: "BE1", BE0: "BE0",
+	}, nil
+}
+
+// brokenNamer is a goodNamer that violates some invariants of the suite.
+type brokenNamer struct {
+	goodNamer
+}
+
+// Fabric returns both a name and an error.
+func (brokenNamer) Fabric(index uint) (string, error) {
+	return fmt.Sprintf("Fabric%d", index), errors.New("fabric error")
+}
+
+// Port gives every port of a slot the name of its first port.
+func (n brokenNamer) Port(pp *namer.PortParams) (string, error) {
+	first := *pp
+	first.PortIndex = 0
+	return n.goodNamer.Port(&first)
+}
+
+// CommonQoSQueues gives both best effort classes the same queue.
+func (n brokenNamer) CommonQoSQueues(qos *namer.QoSParams) (*namer.CommonQoSQueueNames, error) {
+	qn, err := n.goodNamer.CommonQoSQueues(qos)
+	if err != nil {
+		return nil, err
+	}
+	qn.BE1, qn.BE0 = "BE", "BE"
+	return qn, nil
+}